@@ -448,8 +448,13 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_batch_job_queue":           batch.DataSourceJobQueue(),
 			"aws_batch_scheduling_policy":   batch.DataSourceSchedulingPolicy(),
 
-			"aws_ce_cost_category": ce.DataSourceCostCategory(),
-			"aws_ce_tags":          ce.DataSourceTags(),
+			"aws_ce_anomalies":            ce.DataSourceAnomalies(),
+			"aws_ce_anomaly_monitor":      ce.DataSourceAnomalyMonitor(),
+			"aws_ce_anomaly_monitors":     ce.DataSourceAnomalyMonitors(),
+			"aws_ce_anomaly_subscription": ce.DataSourceAnomalySubscription(),
+			"aws_ce_cost_category":        ce.DataSourceCostCategory(),
+			"aws_ce_tags":                 ce.DataSourceTags(),
+			"aws_ce_usage_forecast":       ce.DataSourceUsageForecast(),
 
 			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
 
@@ -768,8 +773,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_mq_broker":                         mq.DataSourceBroker(),
 			"aws_mq_broker_instance_type_offerings": mq.DataSourceBrokerInstanceTypeOfferings(),
 
-			"aws_neptune_engine_version":        neptune.DataSourceEngineVersion(),
-			"aws_neptune_orderable_db_instance": neptune.DataSourceOrderableDBInstance(),
+			"aws_neptune_cluster_endpoint_members": neptune.DataSourceClusterEndpointMembers(),
+			"aws_neptune_cluster_endpoints":        neptune.DataSourceClusterEndpoints(),
+			"aws_neptune_engine_version":           neptune.DataSourceEngineVersion(),
+			"aws_neptune_orderable_db_instance":    neptune.DataSourceOrderableDBInstance(),
 
 			"aws_networkfirewall_firewall":        networkfirewall.DataSourceFirewall(),
 			"aws_networkfirewall_firewall_policy": networkfirewall.DataSourceFirewallPolicy(),
@@ -1750,6 +1757,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_neptune_cluster_parameter_group": neptune.ResourceClusterParameterGroup(),
 			"aws_neptune_cluster_snapshot":        neptune.ResourceClusterSnapshot(),
 			"aws_neptune_event_subscription":      neptune.ResourceEventSubscription(),
+			"aws_neptune_global_cluster":          neptune.ResourceGlobalCluster(),
 			"aws_neptune_parameter_group":         neptune.ResourceParameterGroup(),
 			"aws_neptune_subnet_group":            neptune.ResourceSubnetGroup(),
 
@@ -0,0 +1,257 @@
+package ce
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceAnomalies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAnomaliesRead,
+		Schema: map[string]*schema.Schema{
+			"anomalies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"anomaly_end_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"anomaly_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"anomaly_start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"dimension_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"feedback": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_impact": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"max_score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"monitor_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"root_cause": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"linked_account": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"service": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"usage_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"total_impact": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"date_interval": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 40),
+						},
+						"start_date": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 40),
+						},
+					},
+				},
+			},
+			"feedback": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.AnomalyFeedbackType_Values(), false),
+			},
+			"monitor_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceAnomaliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn
+
+	input := &costexplorer.GetAnomaliesInput{
+		DateInterval: expandAnomaliesDateInterval(d.Get("date_interval").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("feedback"); ok {
+		input.Feedback = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("monitor_arn"); ok {
+		input.MonitorArn = aws.String(v.(string))
+	}
+
+	var anomalies []*costexplorer.Anomaly
+
+	for {
+		resp, err := conn.GetAnomaliesWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.CE, create.ErrActionReading, DSNameAnomalies, d.Id(), err)
+		}
+
+		anomalies = append(anomalies, resp.Anomalies...)
+
+		if aws.StringValue(resp.NextPageToken) == "" {
+			break
+		}
+
+		input.NextPageToken = resp.NextPageToken
+	}
+
+	if err := d.Set("anomalies", flattenAnomalies(anomalies)); err != nil {
+		return create.DiagSettingError(names.CE, DSNameAnomalies, d.Id(), "anomalies", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return nil
+}
+
+func expandAnomaliesDateInterval(tfMap map[string]interface{}) *costexplorer.AnomalyDateInterval {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.AnomalyDateInterval{}
+	apiObject.StartDate = aws.String(tfMap["start_date"].(string))
+	if v, ok := tfMap["end_date"].(string); ok && v != "" {
+		apiObject.EndDate = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAnomaly(apiObject *costexplorer.Anomaly) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"anomaly_end_date":   aws.StringValue(apiObject.AnomalyEndDate),
+		"anomaly_id":         aws.StringValue(apiObject.AnomalyId),
+		"anomaly_start_date": aws.StringValue(apiObject.AnomalyStartDate),
+		"dimension_value":    aws.StringValue(apiObject.DimensionValue),
+		"feedback":           aws.StringValue(apiObject.Feedback),
+		"monitor_arn":        aws.StringValue(apiObject.MonitorArn),
+		"root_cause":         flattenAnomalyRootCauses(apiObject.RootCauses),
+	}
+
+	if v := apiObject.AnomalyScore; v != nil {
+		tfMap["current_score"] = aws.Float64Value(v.CurrentScore)
+		tfMap["max_score"] = aws.Float64Value(v.MaxScore)
+	}
+
+	if v := apiObject.Impact; v != nil {
+		tfMap["max_impact"] = aws.Float64Value(v.MaxImpact)
+		tfMap["total_impact"] = aws.Float64Value(v.TotalImpact)
+	}
+
+	return tfMap
+}
+
+func flattenAnomalies(apiObjects []*costexplorer.Anomaly) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAnomaly(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenAnomalyRootCause(apiObject *costexplorer.RootCause) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"linked_account": aws.StringValue(apiObject.LinkedAccount),
+		"region":         aws.StringValue(apiObject.Region),
+		"service":        aws.StringValue(apiObject.Service),
+		"usage_type":     aws.StringValue(apiObject.UsageType),
+	}
+}
+
+func flattenAnomalyRootCauses(apiObjects []*costexplorer.RootCause) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAnomalyRootCause(apiObject))
+	}
+
+	return tfList
+}
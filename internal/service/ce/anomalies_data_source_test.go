@@ -0,0 +1,51 @@
+package ce_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCEAnomaliesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ce_anomalies.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	formatDate := "2006-01-02"
+	currentTime := time.Now()
+	startDate := currentTime.AddDate(0, -2, 0).Format(formatDate)
+	endDate := currentTime.Format(formatDate)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomaliesDataSourceConfig_basic(rName, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "monitor_arn", "aws_ce_anomaly_monitor.test", "arn"),
+					// A freshly created monitor has not detected any anomalies yet.
+					resource.TestCheckResourceAttr(dataSourceName, "anomalies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAnomaliesDataSourceConfig_basic(rName, startDate, endDate string) string {
+	return acctest.ConfigCompose(testAccAnomalyMonitorConfig_basic(rName), fmt.Sprintf(`
+data "aws_ce_anomalies" "test" {
+  monitor_arn = aws_ce_anomaly_monitor.test.arn
+
+  date_interval {
+    start_date = %[1]q
+    end_date   = %[2]q
+  }
+}
+`, startDate, endDate))
+}
@@ -3,17 +3,22 @@ package ce
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"regexp"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -27,17 +32,63 @@ func ResourceAnomalyMonitor() *schema.Resource {
 		UpdateContext: resourceAnomalyMonitorUpdate,
 		DeleteContext: resourceAnomalyMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceAnomalyMonitorImport,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(anomalyMonitorCreatedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"account_scope": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"monitor_dimension", "monitor_specification"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"linked_account_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+					},
+				},
+			},
+			"adopt_existing_dimensional": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dimensional_value_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"is_account_dimensional": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"monitor_dimension": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"monitor_specification"},
+				ConflictsWith: []string{"account_scope", "monitor_specification"},
 				ValidateFunc:  validation.StringInSlice(costexplorer.MonitorDimension_Values(), false),
 			},
 			"name": {
@@ -51,9 +102,9 @@ func ResourceAnomalyMonitor() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ForceNew:         true,
-				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
-				ConflictsWith:    []string{"monitor_dimension"},
+				ValidateFunc:     validAnomalyMonitorSpecification,
+				DiffSuppressFunc: suppressEquivalentAnomalyMonitorSpecification,
+				ConflictsWith:    []string{"account_scope", "monitor_dimension"},
 			},
 			"monitor_type": {
 				Type:         schema.TypeString,
@@ -65,10 +116,69 @@ func ResourceAnomalyMonitor() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceAnomalyMonitorCustomizeDiffMonitorType,
+			customdiff.ComputedIf("last_updated_date", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Id() != "" && diff.HasChange("name")
+			}),
+		),
 	}
 }
 
+// resourceAnomalyMonitorCustomizeDiffMonitorType rejects the arguments that
+// don't apply to the configured monitor_type, which Create would otherwise
+// silently ignore.
+func resourceAnomalyMonitorCustomizeDiffMonitorType(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !diff.NewValueKnown("monitor_type") {
+		return nil
+	}
+
+	configured := func(name string) bool {
+		v := rawConfig.GetAttr(name)
+
+		if v.IsNull() {
+			return false
+		}
+
+		if !v.IsKnown() {
+			return true
+		}
+
+		if v.Type() == cty.String {
+			return v.AsString() != ""
+		}
+
+		return v.LengthInt() > 0
+	}
+
+	switch monitorType := diff.Get("monitor_type").(string); monitorType {
+	case costexplorer.MonitorTypeCustom:
+		if configured("monitor_dimension") {
+			return fmt.Errorf("monitor_dimension can only be set when monitor_type is %s", costexplorer.MonitorTypeDimensional)
+		}
+	case costexplorer.MonitorTypeDimensional:
+		for _, name := range []string{"account_scope", "monitor_specification"} {
+			if configured(name) {
+				return fmt.Errorf("%s can only be set when monitor_type is %s", name, costexplorer.MonitorTypeCustom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceAnomalyMonitorImport fails fast when the import ID is not an anomaly
+// monitor ARN, e.g. when a subscription ARN is given by mistake.
+func resourceAnomalyMonitorImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, errs := validAnomalyMonitorARN(d.Id(), "import ID"); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAnomalyMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CEConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -88,7 +198,9 @@ func resourceAnomalyMonitorCreate(ctx context.Context, d *schema.ResourceData, m
 			return diag.Errorf("If Monitor Type is %s, dimension attrribute is required", costexplorer.MonitorTypeDimensional)
 		}
 	case costexplorer.MonitorTypeCustom:
-		if v, ok := d.GetOk("monitor_specification"); ok {
+		if v, ok := d.GetOk("account_scope"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AnomalyMonitor.MonitorSpecification = expandAnomalyMonitorAccountScope(v.([]interface{})[0].(map[string]interface{}))
+		} else if v, ok := d.GetOk("monitor_specification"); ok {
 			expression := costexplorer.Expression{}
 
 			if err := json.Unmarshal([]byte(v.(string)), &expression); err != nil {
@@ -108,6 +220,10 @@ func resourceAnomalyMonitorCreate(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := conn.CreateAnomalyMonitorWithContext(ctx, input)
 
+	if err != nil && d.Get("adopt_existing_dimensional").(bool) && d.Get("monitor_type").(string) == costexplorer.MonitorTypeDimensional && isDimensionalMonitorLimitError(err) {
+		return resourceAnomalyMonitorAdoptDimensional(ctx, d, meta)
+	}
+
 	if err != nil {
 		return diag.Errorf("Error creating Anomaly Monitor: %s", err)
 	}
@@ -118,9 +234,64 @@ func resourceAnomalyMonitorCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.StringValue(resp.MonitorArn))
 
+	if _, err := WaitAnomalyMonitorAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.CE, create.ErrActionWaitingForCreation, ResNameAnomalyMonitor, d.Id(), err)
+	}
+
+	return resourceAnomalyMonitorRead(ctx, d, meta)
+}
+
+// resourceAnomalyMonitorAdoptDimensional takes ownership of the account's
+// existing DIMENSIONAL monitor and updates it to match the configuration.
+func resourceAnomalyMonitorAdoptDimensional(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn
+
+	monitor, err := FindDimensionalAnomalyMonitor(ctx, conn)
+
+	if err != nil {
+		return create.DiagError(names.CE, create.ErrActionCreating, ResNameAnomalyMonitor, d.Get("name").(string), fmt.Errorf("finding existing DIMENSIONAL monitor to adopt: %w", err))
+	}
+
+	arn := aws.StringValue(monitor.MonitorArn)
+
+	// The ID is only set once everything that can fail has succeeded. A create that
+	// fails with the ID set would leave the adopted monitor tainted, and the next
+	// apply would delete the account's only DIMENSIONAL monitor.
+	if v := d.Get("monitor_dimension").(string); v != aws.StringValue(monitor.MonitorDimension) {
+		return create.DiagError(names.CE, create.ErrActionCreating, ResNameAnomalyMonitor, arn, fmt.Errorf("existing DIMENSIONAL monitor has dimension %s, configured %s", aws.StringValue(monitor.MonitorDimension), v))
+	}
+
+	if name := d.Get("name").(string); name != aws.StringValue(monitor.MonitorName) {
+		_, err := conn.UpdateAnomalyMonitorWithContext(ctx, &costexplorer.UpdateAnomalyMonitorInput{
+			MonitorArn:  monitor.MonitorArn,
+			MonitorName: aws.String(name),
+		})
+
+		if err != nil {
+			return create.DiagError(names.CE, create.ErrActionUpdating, ResNameAnomalyMonitor, arn, err)
+		}
+	}
+
+	tags := meta.(*conns.AWSClient).DefaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		if err := UpdateTagsWithContext(ctx, conn, arn, nil, tags.IgnoreAWS().Map()); err != nil {
+			return create.DiagError(names.CE, create.ErrActionUpdating, ResNameAnomalyMonitor, arn, err)
+		}
+	}
+
+	d.SetId(arn)
+
 	return resourceAnomalyMonitorRead(ctx, d, meta)
 }
 
+// isDimensionalMonitorLimitError returns whether err is the error Cost Explorer
+// returns when the account already has its single allowed DIMENSIONAL monitor.
+// Other validation and limit errors must not lead to adopting that monitor.
+func isDimensionalMonitorLimitError(err error) bool {
+	return tfawserr.ErrMessageContains(err, errCodeValidationException, errMessageDimensionalMonitorLimit)
+}
+
 func resourceAnomalyMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CEConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -138,32 +309,63 @@ func resourceAnomalyMonitorRead(ctx context.Context, d *schema.ResourceData, met
 		return create.DiagError(names.CE, create.ErrActionReading, ResNameAnomalyMonitor, d.Id(), err)
 	}
 
-	if monitor.MonitorSpecification != nil {
+	var diags diag.Diagnostics
+
+	// A monitor created from account_scope keeps its specification out of state,
+	// as the configuration has none to compare it with.
+	accountScope := len(d.Get("account_scope").([]interface{})) > 0
+
+	// Only an imported CUSTOM monitor is read without a specification in state.
+	imported := !d.IsNewResource() && !accountScope && d.Get("monitor_specification").(string) == ""
+
+	if tfMap := flattenAnomalyMonitorAccountScope(monitor.MonitorSpecification); accountScope && tfMap != nil {
+		if err := d.Set("account_scope", []interface{}{tfMap}); err != nil {
+			return create.DiagError(names.CE, create.ErrActionReading, ResNameAnomalyMonitor, d.Id(), err)
+		}
+	} else if monitor.MonitorSpecification != nil {
+		d.Set("account_scope", nil)
+
 		specificationToJson, err := json.Marshal(monitor.MonitorSpecification)
 		if err != nil {
 			return diag.Errorf("Error parsing specification response: %s", err)
 		}
-		specificationToSet, err := structure.NormalizeJsonString(string(specificationToJson))
+		specificationToSet, err := canonicalAnomalyMonitorSpecification(string(specificationToJson))
 
 		if err != nil {
 			return diag.Errorf("Specification (%s) is invalid JSON: %s", specificationToSet, err)
 		}
 
 		d.Set("monitor_specification", specificationToSet)
+
+		if imported {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Imported Cost Explorer Anomaly Monitor (%s) specification is in its normalized form", d.Id()),
+				Detail: fmt.Sprintf("Cost Explorer stores monitor_specification in a normalized form, which may fill in fields such as MatchOptions that weren't specified when the monitor was created. "+
+					"Key order, whitespace and null values are ignored when comparing it with the configuration, but any other difference will be planned as an update. "+
+					"The imported specification is:\n\n%s", specificationToSet),
+			})
+		}
 	}
 
 	d.Set("arn", monitor.MonitorArn)
+	d.Set("creation_date", monitor.CreationDate)
+	d.Set("last_updated_date", monitor.LastUpdatedDate)
+	d.Set("dimensional_value_count", monitor.DimensionalValueCount)
+	// An account can only have one DIMENSIONAL monitor, so no scan is needed.
+	d.Set("is_account_dimensional", aws.StringValue(monitor.MonitorType) == costexplorer.MonitorTypeDimensional)
 	d.Set("monitor_dimension", monitor.MonitorDimension)
 	d.Set("name", monitor.MonitorName)
 	d.Set("monitor_type", monitor.MonitorType)
 
 	tags, err := ListTags(conn, aws.StringValue(monitor.MonitorArn))
-	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	if err != nil {
 		return create.DiagError(names.CE, create.ErrActionReading, ResNameAnomalyMonitor, d.Id(), err)
 	}
 
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return create.DiagError(names.CE, create.ErrActionReading, ResNameAnomalyMonitor, d.Id(), err)
@@ -173,7 +375,7 @@ func resourceAnomalyMonitorRead(ctx context.Context, d *schema.ResourceData, met
 		return create.DiagError(names.CE, create.ErrActionReading, ResNameAnomalyMonitor, d.Id(), err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceAnomalyMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -189,14 +391,6 @@ func resourceAnomalyMonitorUpdate(ctx context.Context, d *schema.ResourceData, m
 		requestUpdate = true
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
-
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return create.DiagError(names.CE, create.ErrActionUpdating, ResNameAnomalyMonitor, d.Id(), err)
-		}
-	}
-
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -216,6 +410,36 @@ func resourceAnomalyMonitorUpdate(ctx context.Context, d *schema.ResourceData, m
 	return resourceAnomalyMonitorRead(ctx, d, meta)
 }
 
+// expandAnomalyMonitorAccountScope returns the CUSTOM monitor specification for
+// an account_scope block: a LINKED_ACCOUNT dimension with the sorted account IDs.
+func expandAnomalyMonitorAccountScope(tfMap map[string]interface{}) *costexplorer.Expression {
+	ids := flex.ExpandStringValueSet(tfMap["linked_account_ids"].(*schema.Set))
+	sort.Strings(ids)
+
+	return &costexplorer.Expression{
+		Dimensions: &costexplorer.DimensionValues{
+			Key:    aws.String(costexplorer.DimensionLinkedAccount),
+			Values: aws.StringSlice(ids),
+		},
+	}
+}
+
+// flattenAnomalyMonitorAccountScope returns the account_scope block for a
+// specification that is a single LINKED_ACCOUNT dimension, and nil for any
+// other specification. The match options that Cost Explorer may fill in are
+// ignored, as account_scope doesn't set them.
+func flattenAnomalyMonitorAccountScope(apiObject *costexplorer.Expression) map[string]interface{} {
+	if apiObject == nil || apiObject.Dimensions == nil || apiObject.And != nil || apiObject.Or != nil || apiObject.Not != nil ||
+		apiObject.CostCategories != nil || apiObject.Tags != nil ||
+		aws.StringValue(apiObject.Dimensions.Key) != costexplorer.DimensionLinkedAccount || len(apiObject.Dimensions.Values) == 0 {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"linked_account_ids": flex.FlattenStringValueSet(aws.StringValueSlice(apiObject.Dimensions.Values)),
+	}
+}
+
 func resourceAnomalyMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CEConn
 
@@ -231,3 +455,59 @@ func resourceAnomalyMonitorDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// canonicalAnomalyMonitorSpecification returns the normalized JSON of an
+// Expression without its null-valued keys. The SDK marshals every unset field of
+// an Expression as null, so without this a specification read back from AWS
+// never matches one written without the nulls.
+func canonicalAnomalyMonitorSpecification(v string) (string, error) {
+	var specification interface{}
+
+	if err := json.Unmarshal([]byte(v), &specification); err != nil {
+		return v, err
+	}
+
+	b, err := json.Marshal(removeJSONNulls(specification))
+
+	if err != nil {
+		return v, err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
+func removeJSONNulls(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if value == nil {
+				delete(v, key)
+				continue
+			}
+
+			v[key] = removeJSONNulls(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = removeJSONNulls(value)
+		}
+	}
+
+	return v
+}
+
+func suppressEquivalentAnomalyMonitorSpecification(k, old, new string, d *schema.ResourceData) bool {
+	oldSpecification, err := canonicalAnomalyMonitorSpecification(old)
+
+	if err != nil {
+		return false
+	}
+
+	newSpecification, err := canonicalAnomalyMonitorSpecification(new)
+
+	if err != nil {
+		return false
+	}
+
+	return oldSpecification == newSpecification
+}
@@ -0,0 +1,93 @@
+package ce
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceAnomalyMonitor() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAnomalyMonitorRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_dimension": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_specification": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAnomalyMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	monitor, err := FindAnomalyMonitorByName(ctx, conn, d.Get("name").(string))
+
+	if err != nil {
+		return diag.FromErr(tfresource.SingularDataSourceFindError("Cost Explorer Anomaly Monitor", err))
+	}
+
+	d.SetId(aws.StringValue(monitor.MonitorArn))
+	d.Set("arn", monitor.MonitorArn)
+	d.Set("monitor_dimension", monitor.MonitorDimension)
+	d.Set("monitor_type", monitor.MonitorType)
+	d.Set("name", monitor.MonitorName)
+
+	if monitor.MonitorSpecification != nil {
+		b, err := json.Marshal(monitor.MonitorSpecification)
+
+		if err != nil {
+			return create.DiagError(names.CE, create.ErrActionReading, DSNameAnomalyMonitor, d.Id(), err)
+		}
+
+		specification, err := canonicalAnomalyMonitorSpecification(string(b))
+
+		if err != nil {
+			return create.DiagError(names.CE, create.ErrActionReading, DSNameAnomalyMonitor, d.Id(), err)
+		}
+
+		d.Set("monitor_specification", specification)
+	} else {
+		d.Set("monitor_specification", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return create.DiagError(names.CE, "listing tags", DSNameAnomalyMonitor, d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return create.DiagError(names.CE, "setting tags", DSNameAnomalyMonitor, d.Id(), err)
+	}
+
+	return nil
+}
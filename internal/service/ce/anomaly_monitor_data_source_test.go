@@ -0,0 +1,68 @@
+package ce_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCEAnomalyMonitorDataSource_basic(t *testing.T) {
+	resourceName := "aws_ce_anomaly_monitor.test"
+	dataSourceName := "data.aws_ce_anomaly_monitor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "monitor_type", resourceName, "monitor_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "monitor_specification", resourceName, "monitor_specification"),
+					resource.TestCheckResourceAttr(dataSourceName, "monitor_dimension", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitorDataSource_notFound(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalyMonitorDataSourceConfig_name(rName),
+				ExpectError: regexp.MustCompile(`no matching Cost Explorer Anomaly Monitor found`),
+			},
+		},
+	})
+}
+
+func testAccAnomalyMonitorDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAnomalyMonitorConfig_basic(rName), `
+data "aws_ce_anomaly_monitor" "test" {
+  name = aws_ce_anomaly_monitor.test.name
+}
+`)
+}
+
+func testAccAnomalyMonitorDataSourceConfig_name(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ce_anomaly_monitor" "test" {
+  name = %[1]q
+}
+`, rName)
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -38,12 +38,108 @@ func TestAccCEAnomalyMonitor_basic(t *testing.T) {
 					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`anomalymonitor/.+`)),
 					resource.TestCheckResourceAttr(resourceName, "monitor_type", "CUSTOM"),
 					resource.TestCheckResourceAttrSet(resourceName, "monitor_specification"),
+					resource.TestCheckResourceAttr(resourceName, "is_account_dimensional", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing_dimensional"},
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "arn:aws:ce::123456789012:anomalysubscription/12345678-1234-1234-1234-123456789012",
+				ExpectError:   regexp.MustCompile(`is not a Cost Explorer anomaly monitor ARN`),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_customMatchOptions(t *testing.T) {
+	var monitor costexplorer.AnomalyMonitor
+	resourceName := "aws_ce_anomaly_monitor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig_customMatchOptions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName, &monitor),
+					resource.TestCheckResourceAttr(resourceName, "monitor_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_specification", `{"Tags":{"Key":"CostCenter","MatchOptions":["EQUALS"],"Values":["10000","20000"]}}`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing_dimensional"},
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_accountScope(t *testing.T) {
+	var monitor costexplorer.AnomalyMonitor
+	resourceName := "aws_ce_anomaly_monitor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig_accountScope(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName, &monitor),
+					resource.TestCheckResourceAttr(resourceName, "monitor_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "account_scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_scope.0.linked_account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_scope.0.linked_account_ids.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_specification", ""),
+				),
+			},
+			{
+				Config:   testAccAnomalyMonitorConfig_accountScope(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_scope", "adopt_existing_dimensional", "monitor_specification"},
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_monitorTypeArguments(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalyMonitorConfig_customDimension(rName),
+				ExpectError: regexp.MustCompile(`monitor_dimension can only be set when monitor_type is DIMENSIONAL`),
+			},
+			{
+				Config:      testAccAnomalyMonitorConfig_dimensionalAccountScope(rName),
+				ExpectError: regexp.MustCompile(`account_scope can only be set when monitor_type is CUSTOM`),
 			},
 		},
 	})
@@ -92,15 +188,17 @@ func TestAccCEAnomalyMonitor_update(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing_dimensional"},
 			},
 			{
 				Config: testAccAnomalyMonitorConfig_basic(rName2),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAnomalyMonitorExists(resourceName, &monitor),
 					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
 				),
 			},
 		},
@@ -127,9 +225,10 @@ func TestAccCEAnomalyMonitor_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing_dimensional"},
 			},
 			{
 				Config: testAccAnomalyMonitorConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
@@ -152,12 +251,49 @@ func TestAccCEAnomalyMonitor_tags(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalyMonitor_ignoreTags(t *testing.T) {
+	var monitor costexplorer.AnomalyMonitor
+	resourceName := "aws_ce_anomaly_monitor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName, &monitor),
+					testAccCheckAnomalyMonitorUpdateTags(&monitor, nil, map[string]string{"ignorekey1": "ignorevalue1"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: acctest.ConfigCompose(acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"), testAccAnomalyMonitorConfig_tags1(rName, "key1", "value1")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckNoResourceAttr(resourceName, "tags.ignorekey1"),
+					resource.TestCheckNoResourceAttr(resourceName, "tags_all.ignorekey1"),
+				),
+			},
+			{
+				Config:   acctest.ConfigCompose(acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"), testAccAnomalyMonitorConfig_tags1(rName, "key1", "value1")),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 // An AWS account can only have one anomaly monitor of type DIMENSIONAL. As
 // such, if additional tests are added, they should be combined with the
 // following test in a serial test
 func TestAccCEAnomalyMonitor_Dimensional(t *testing.T) {
 	var monitor costexplorer.AnomalyMonitor
 	resourceName := "aws_ce_anomaly_monitor.test"
+	adoptResourceName := "aws_ce_anomaly_monitor.adopt"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -173,12 +309,23 @@ func TestAccCEAnomalyMonitor_Dimensional(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "monitor_type", "DIMENSIONAL"),
 					resource.TestCheckResourceAttr(resourceName, "monitor_dimension", "SERVICE"),
+					resource.TestCheckResourceAttrSet(resourceName, "dimensional_value_count"),
+					resource.TestCheckResourceAttr(resourceName, "is_account_dimensional", "true"),
+				),
+			},
+			{
+				Config: testAccAnomalyMonitorConfig_dimensionalAdopt(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(adoptResourceName, &monitor),
+					resource.TestCheckResourceAttrPair(adoptResourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(adoptResourceName, "name", rName),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing_dimensional"},
 			},
 		},
 	})
@@ -190,21 +337,17 @@ func testAccCheckAnomalyMonitorExists(n string, anomalyMonitor *costexplorer.Ano
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
-			return fmt.Errorf("Not found: %s", n)
+			return create.Error(names.CE, create.ErrActionCheckingExistence, tfce.ResNameAnomalyMonitor, n, errors.New("not found in state"))
 		}
 
 		if rs.Primary.ID == "" {
-			return fmt.Errorf("No Cost Explorer Anomaly Monitor is set")
+			return create.Error(names.CE, create.ErrActionCheckingExistence, tfce.ResNameAnomalyMonitor, n, errors.New("no ID is set"))
 		}
 
 		resp, err := tfce.FindAnomalyMonitorByARN(context.Background(), conn, rs.Primary.ID)
 
 		if err != nil {
-			return err
-		}
-
-		if resp == nil {
-			return fmt.Errorf("Cost Explorer %q does not exist", rs.Primary.ID)
+			return create.Error(names.CE, create.ErrActionCheckingExistence, tfce.ResNameAnomalyMonitor, rs.Primary.ID, err)
 		}
 
 		*anomalyMonitor = *resp
@@ -213,30 +356,40 @@ func testAccCheckAnomalyMonitorExists(n string, anomalyMonitor *costexplorer.Ano
 	}
 }
 
+func testAccCheckAnomalyMonitorUpdateTags(monitor *costexplorer.AnomalyMonitor, oldTags, newTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CEConn
+
+		return tfce.UpdateTags(conn, aws.StringValue(monitor.MonitorArn), oldTags, newTags)
+	}
+}
+
 func testAccCheckAnomalyMonitorDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CEConn
 
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_ce_anomaly_monitor" {
-			continue
-		}
+	// List every monitor once rather than looking up each one in state.
+	monitors, err := tfce.FindAnomalyMonitors(context.Background(), conn, &costexplorer.GetAnomalyMonitorsInput{})
+
+	if err != nil {
+		return err
+	}
 
-		_, err := tfce.FindAnomalyMonitorByARN(context.Background(), conn, rs.Primary.ID)
+	arns := make(map[string]bool, len(monitors))
+	for _, monitor := range monitors {
+		arns[aws.StringValue(monitor.MonitorArn)] = true
+	}
 
-		if tfresource.NotFound(err) {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_anomaly_monitor" {
 			continue
 		}
 
-		if err != nil {
-			return err
+		if arns[rs.Primary.ID] {
+			return create.Error(names.CE, create.ErrActionCheckingDestroyed, tfce.ResNameAnomalyMonitor, rs.Primary.ID, errors.New("still exists"))
 		}
-
-		return create.Error(names.CE, create.ErrActionCheckingDestroyed, tfce.ResNameAnomalyMonitor, rs.Primary.ID, errors.New("still exists"))
-
 	}
 
 	return nil
-
 }
 
 func testAccAnomalyMonitorConfig_basic(rName string) string {
@@ -265,6 +418,61 @@ JSON
 `, rName)
 }
 
+func testAccAnomalyMonitorConfig_customMatchOptions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification = jsonencode({
+    Tags = {
+      Key          = "CostCenter"
+      MatchOptions = ["EQUALS"]
+      Values       = ["10000", "20000"]
+    }
+  })
+}
+`, rName)
+}
+
+func testAccAnomalyMonitorConfig_accountScope(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  account_scope {
+    linked_account_ids = [data.aws_caller_identity.current.account_id]
+  }
+}
+`, rName)
+}
+
+func testAccAnomalyMonitorConfig_customDimension(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name              = %[1]q
+  monitor_type      = "CUSTOM"
+  monitor_dimension = "SERVICE"
+}
+`, rName)
+}
+
+func testAccAnomalyMonitorConfig_dimensionalAccountScope(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "DIMENSIONAL"
+
+  account_scope {
+    linked_account_ids = ["123456789012"]
+  }
+}
+`, rName)
+}
+
 func testAccAnomalyMonitorConfig_tags1(rName string, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`	
 resource "aws_ce_anomaly_monitor" "test" {
@@ -335,3 +543,16 @@ resource "aws_ce_anomaly_monitor" "test" {
 }
 `, rName)
 }
+
+func testAccAnomalyMonitorConfig_dimensionalAdopt(rName string) string {
+	return acctest.ConfigCompose(testAccAnomalyMonitorConfig_dimensional(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "adopt" {
+  name                       = %[1]q
+  monitor_type               = "DIMENSIONAL"
+  monitor_dimension          = "SERVICE"
+  adopt_existing_dimensional = true
+
+  depends_on = [aws_ce_anomaly_monitor.test]
+}
+`, rName))
+}
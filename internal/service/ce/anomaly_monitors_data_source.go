@@ -0,0 +1,95 @@
+package ce
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceAnomalyMonitors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAnomalyMonitorsRead,
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"monitor_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.MonitorType_Values(), false),
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceAnomalyMonitorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn
+
+	monitors, err := FindAnomalyMonitors(ctx, conn, &costexplorer.GetAnomalyMonitorsInput{})
+
+	if err != nil {
+		return create.DiagError(names.CE, create.ErrActionReading, DSNameAnomalyMonitors, d.Id(), err)
+	}
+
+	monitorType := d.Get("monitor_type").(string)
+	namePrefix := d.Get("name_prefix").(string)
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS()
+
+	var arns, monitorNames []string
+
+	for _, monitor := range monitors {
+		if monitorType != "" && aws.StringValue(monitor.MonitorType) != monitorType {
+			continue
+		}
+
+		if !strings.HasPrefix(aws.StringValue(monitor.MonitorName), namePrefix) {
+			continue
+		}
+
+		arn := aws.StringValue(monitor.MonitorArn)
+
+		// Only look up tags when filtering on them, as it costs one call per monitor.
+		if len(tagsToMatch) > 0 {
+			tags, err := ListTagsWithContext(ctx, conn, arn)
+
+			if err != nil {
+				return create.DiagError(names.CE, "listing tags", DSNameAnomalyMonitors, arn, err)
+			}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		arns = append(arns, arn)
+		monitorNames = append(monitorNames, aws.StringValue(monitor.MonitorName))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("arns", arns)
+	d.Set("names", monitorNames)
+
+	return nil
+}
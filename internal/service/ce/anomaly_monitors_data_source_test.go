@@ -0,0 +1,51 @@
+package ce_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCEAnomalyMonitorsDataSource_basic(t *testing.T) {
+	resourceName := "aws_ce_anomaly_monitor.test"
+	dataSourceName := "data.aws_ce_anomaly_monitors.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAnomalyMonitorsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAnomalyMonitorConfig_tags1(rName, "Team", rName),
+		fmt.Sprintf(`
+data "aws_ce_anomaly_monitors" "test" {
+  name_prefix  = %[1]q
+  monitor_type = "CUSTOM"
+
+  tags = {
+    Team = %[1]q
+  }
+
+  depends_on = [aws_ce_anomaly_monitor.test]
+}
+`, rName))
+}
@@ -2,16 +2,21 @@ package ce
 
 import (
 	"context"
+	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -27,6 +32,12 @@ func ResourceAnomalySubscription() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(anomalySubscriptionCreatedTimeout),
+			Delete: schema.DefaultTimeout(anomalySubscriptionDeletedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:         schema.TypeString,
@@ -83,10 +94,60 @@ func ResourceAnomalySubscription() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"validate_monitor_arns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"validate_sns_topic_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceAnomalySubscriptionCustomizeDiffSubscribers,
+			resourceAnomalySubscriptionCustomizeDiffMonitorARNs,
+		),
+	}
+}
+
+func resourceAnomalySubscriptionCustomizeDiffSubscribers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("subscriber") {
+		return nil
 	}
+
+	return validAnomalySubscriptionSubscribers(diff.Get("subscriber").(*schema.Set).List())
+}
+
+// resourceAnomalySubscriptionCustomizeDiffMonitorARNs checks, when opted in,
+// that every monitor in monitor_arn_list exists. ARNs that are unknown until
+// apply, e.g. of monitors created in the same apply, are skipped.
+func resourceAnomalySubscriptionCustomizeDiffMonitorARNs(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_monitor_arns").(bool) || (diff.Id() != "" && !diff.HasChange("monitor_arn_list")) {
+		return nil
+	}
+
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	v := rawConfig.GetAttr("monitor_arn_list")
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	var arns []string
+	for _, arn := range v.AsValueSlice() {
+		if arn.IsKnown() && !arn.IsNull() {
+			arns = append(arns, arn.AsString())
+		}
+	}
+
+	return checkAnomalySubscriptionMonitors(ctx, meta.(*conns.AWSClient).CEConn, arns)
 }
 
 func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -94,6 +155,12 @@ func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceDa
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	if d.Get("validate_sns_topic_policy").(bool) {
+		if err := checkAnomalySubscriptionTopicPolicies(meta.(*conns.AWSClient).SNSConn, d.Get("subscriber").(*schema.Set).List()); err != nil {
+			return create.DiagError(names.CE, create.ErrActionCreating, ResNameAnomalySubscription, d.Get("name").(string), err)
+		}
+	}
+
 	input := &costexplorer.CreateAnomalySubscriptionInput{
 		AnomalySubscription: &costexplorer.AnomalySubscription{
 			SubscriptionName: aws.String(d.Get("name").(string)),
@@ -124,6 +191,10 @@ func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceDa
 
 	d.SetId(aws.StringValue(resp.SubscriptionArn))
 
+	if _, err := WaitAnomalySubscriptionAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.CE, create.ErrActionWaitingForCreation, ResNameAnomalySubscription, d.Id(), err)
+	}
+
 	return resourceAnomalySubscriptionRead(ctx, d, meta)
 }
 
@@ -190,6 +261,12 @@ func resourceAnomalySubscriptionUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if d.HasChange("subscriber") {
+		if d.Get("validate_sns_topic_policy").(bool) {
+			if err := checkAnomalySubscriptionTopicPolicies(meta.(*conns.AWSClient).SNSConn, d.Get("subscriber").(*schema.Set).List()); err != nil {
+				return create.DiagError(names.CE, create.ErrActionUpdating, ResNameAnomalySubscription, d.Id(), err)
+			}
+		}
+
 		input.Subscribers = expandAnomalySubscriptionSubscribers(d.Get("subscriber").(*schema.Set).List())
 		requestUpdate = true
 	}
@@ -239,6 +316,60 @@ func resourceAnomalySubscriptionDelete(ctx context.Context, d *schema.ResourceDa
 		return create.DiagError(names.CE, create.ErrActionDeleting, ResNameAnomalySubscription, d.Id(), err)
 	}
 
+	if _, err := WaitAnomalySubscriptionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.CE, create.ErrActionWaitingForDeletion, ResNameAnomalySubscription, d.Id(), err)
+	}
+
+	return nil
+}
+
+// checkAnomalySubscriptionTopicPolicies reads the access policy of every SNS
+// subscriber topic and verifies that Cost Anomaly Detection can publish to it.
+func checkAnomalySubscriptionTopicPolicies(conn *sns.SNS, rawSubscribers []interface{}) error {
+	for _, sub := range rawSubscribers {
+		rawSubMap := sub.(map[string]interface{})
+
+		if rawSubMap["type"].(string) != costexplorer.SubscriberTypeSns {
+			continue
+		}
+
+		topicARN := rawSubMap["address"].(string)
+		attributes, err := tfsns.FindTopicAttributesByARN(conn, topicARN)
+
+		if err != nil {
+			return fmt.Errorf("reading SNS Topic (%s) policy: %w", topicARN, err)
+		}
+
+		if err := validAnomalySubscriptionTopicPolicy(attributes[tfsns.TopicAttributeNamePolicy]); err != nil {
+			return fmt.Errorf("SNS Topic (%s): %w", topicARN, err)
+		}
+	}
+
+	return nil
+}
+
+// checkAnomalySubscriptionMonitors verifies that each of the anomaly monitors
+// exists, and names all of those that don't.
+func checkAnomalySubscriptionMonitors(ctx context.Context, conn *costexplorer.CostExplorer, arns []string) error {
+	var missing []string
+
+	for _, arn := range arns {
+		_, err := FindAnomalyMonitorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			missing = append(missing, arn)
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading Cost Explorer Anomaly Monitor (%s): %w", arn, err)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("monitor_arn_list: anomaly monitors don't exist: %s", strings.Join(missing, ", "))
+	}
+
 	return nil
 }
 
@@ -0,0 +1,125 @@
+package ce
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceAnomalySubscription() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAnomalySubscriptionRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"frequency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_arn_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscriber": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAnomalySubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	arn := d.Get("arn").(string)
+	subscription, err := FindAnomalySubscriptionByARN(ctx, conn, arn)
+
+	if err != nil {
+		return create.DiagError(names.CE, create.ErrActionReading, DSNameAnomalySubscription, arn, err)
+	}
+
+	d.SetId(aws.StringValue(subscription.SubscriptionArn))
+	d.Set("account_id", subscription.AccountId)
+	d.Set("frequency", subscription.Frequency)
+	d.Set("monitor_arn_list", aws.StringValueSlice(subscription.MonitorArnList))
+	d.Set("name", subscription.SubscriptionName)
+	if err := d.Set("subscriber", flattenAnomalySubscriptionSubscribersWithStatus(subscription.Subscribers)); err != nil {
+		return create.DiagError(names.CE, "setting subscriber", DSNameAnomalySubscription, d.Id(), err)
+	}
+	d.Set("threshold", subscription.Threshold)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return create.DiagError(names.CE, "listing tags", DSNameAnomalySubscription, d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return create.DiagError(names.CE, "setting tags", DSNameAnomalySubscription, d.Id(), err)
+	}
+
+	return nil
+}
+
+// flattenAnomalySubscriptionSubscribersWithStatus also returns whether each
+// subscriber has confirmed (CONFIRMED) or declined (DECLINED) notifications.
+// The resource leaves the status out as it would change the subscriber set hash.
+func flattenAnomalySubscriptionSubscribersWithStatus(apiObjects []*costexplorer.Subscriber) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"address": aws.StringValue(apiObject.Address),
+			"status":  aws.StringValue(apiObject.Status),
+			"type":    aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
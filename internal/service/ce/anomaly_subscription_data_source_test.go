@@ -0,0 +1,50 @@
+package ce_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCEAnomalySubscriptionDataSource_basic(t *testing.T) {
+	resourceName := "aws_ce_anomaly_subscription.test"
+	dataSourceName := "data.aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionDataSourceConfig_basic(rName, address),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "frequency", resourceName, "frequency"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "threshold", resourceName, "threshold"),
+					resource.TestCheckResourceAttr(dataSourceName, "monitor_arn_list.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriber.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriber.0.address", address),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriber.0.type", "EMAIL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subscriber.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAnomalySubscriptionDataSourceConfig_basic(rName, address string) string {
+	return acctest.ConfigCompose(
+		testAccAnomalySubscriptionConfig_basic(rName, address),
+		`
+data "aws_ce_anomaly_subscription" "test" {
+  arn = aws_ce_anomaly_subscription.test.arn
+}
+`)
+}
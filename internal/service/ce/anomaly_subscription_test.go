@@ -221,6 +221,67 @@ func TestAccCEAnomalySubscription_Subscriber(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_validateMonitorARNs(t *testing.T) {
+	var subscription costexplorer.AnomalySubscription
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_validateMonitorARNsMissing(rName),
+				ExpectError: regexp.MustCompile(`anomaly monitors don't exist: arn:[^:]+:ce::\d{12}:anomalymonitor/00000000-0000-0000-0000-000000000000`),
+			},
+			{
+				// The monitor created in the same apply has an unknown ARN at plan time.
+				Config: testAccAnomalySubscriptionConfig_validateMonitorARNs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "validate_monitor_arns", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "monitor_arn_list.0", "aws_ce_anomaly_monitor.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_validateSNSTopicPolicy(t *testing.T) {
+	var subscription costexplorer.AnomalySubscription
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_validateSNSTopicPolicyMissing(rName),
+				ExpectError: regexp.MustCompile(`does not allow costalerts.amazonaws.com the sns:Publish action`),
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig_validateSNSTopicPolicy(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "validate_sns_topic_policy", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "subscriber.0.address", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_sns_topic_policy"},
+			},
+		},
+	})
+}
+
 func TestAccCEAnomalySubscription_Threshold(t *testing.T) {
 	var subscription costexplorer.AnomalySubscription
 	resourceName := "aws_ce_anomaly_subscription.test"
@@ -383,6 +444,54 @@ JSON
 `, rName)
 }
 
+func testAccAnomalySubscriptionConfig_validateMonitorARNsMissing(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  threshold = 100
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    "arn:${data.aws_partition.current.partition}:ce::${data.aws_caller_identity.current.account_id}:anomalymonitor/00000000-0000-0000-0000-000000000000",
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = "test@example.com"
+  }
+
+  validate_monitor_arns = true
+}
+`, rName)
+}
+
+func testAccAnomalySubscriptionConfig_validateMonitorARNs(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAnomalySubscriptionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  threshold = 100
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = "test@example.com"
+  }
+
+  validate_monitor_arns = true
+}
+`, rName))
+}
+
 func testAccAnomalySubscriptionConfig_basic(rName string, address string) string {
 	return acctest.ConfigCompose(
 		testAccAnomalySubscriptionConfigBase(rName),
@@ -589,6 +698,82 @@ resource "aws_ce_anomaly_subscription" "test" {
 `, rName))
 }
 
+func testAccAnomalySubscriptionConfig_validateSNSTopicPolicyMissing(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAnomalySubscriptionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  threshold = 100000000
+  frequency = "IMMEDIATE"
+
+  validate_sns_topic_policy = true
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "SNS"
+    address = aws_sns_topic.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccAnomalySubscriptionConfig_validateSNSTopicPolicy(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAnomalySubscriptionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["SNS:Publish"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["costalerts.amazonaws.com"]
+    }
+
+    resources = [aws_sns_topic.test.arn]
+  }
+}
+
+resource "aws_sns_topic_policy" "test" {
+  arn    = aws_sns_topic.test.arn
+  policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  threshold = 100000000
+  frequency = "IMMEDIATE"
+
+  validate_sns_topic_policy = true
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "SNS"
+    address = aws_sns_topic.test.arn
+  }
+
+  depends_on = [
+    aws_sns_topic_policy.test,
+  ]
+}
+`, rName))
+}
+
 func testAccAnomalySubscriptionConfig_threshold(rName string, rThreshold int, address string) string {
 	return acctest.ConfigCompose(
 		testAccAnomalySubscriptionConfigBase(rName),
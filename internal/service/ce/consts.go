@@ -5,5 +5,16 @@ const (
 	ResNameAnomalySubscription = "Anomaly Subscription"
 	ResNameCostCategory        = "Cost Category"
 	ResNameCostAllocationTag   = "Cost Allocation Tags"
+	DSNameAnomalies            = "Anomalies Data Source"
+	DSNameAnomalyMonitor       = "Anomaly Monitor Data Source"
+	DSNameAnomalyMonitors      = "Anomaly Monitors Data Source"
+	DSNameAnomalySubscription  = "Anomaly Subscription Data Source"
 	DSNameTags                 = "Tags Data Source"
+	DSNameUsageForecast        = "Usage Forecast Data Source"
+)
+
+const (
+	errCodeValidationException = "ValidationException"
+
+	errMessageDimensionalMonitorLimit = "Limit exceeded on dimensional spend monitor creation"
 )
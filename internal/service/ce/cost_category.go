@@ -2,6 +2,9 @@ package ce
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
@@ -30,7 +33,10 @@ func ResourceCostCategory() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: customdiff.Sequence(verify.SetTagsDiff),
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceCostCategoryCustomizeDiffRules,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -370,6 +376,14 @@ func schemaCostCategoryRuleExpression() *schema.Resource {
 	}
 }
 
+func resourceCostCategoryCustomizeDiffRules(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("rule") {
+		return nil
+	}
+
+	return validCostCategoryRules(diff.Get("rule").(*schema.Set).List())
+}
+
 func resourceCostCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CEConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -389,6 +403,11 @@ func resourceCostCategoryCreate(ctx context.Context, d *schema.ResourceData, met
 		input.SplitChargeRules = expandCostCategorySplitChargeRules(v.(*schema.Set).List())
 	}
 
+	// Keys with the reserved "aws:" prefix are rejected by the API and can't be set.
+	if ignored := tags.Removed(tags.IgnoreAWS()); len(ignored) > 0 {
+		log.Printf("[WARN] Ignoring reserved tag keys for Cost Explorer Cost Category (%s): %s", d.Get("name").(string), strings.Join(ignored.Keys(), ", "))
+	}
+
 	if len(tags) > 0 {
 		input.ResourceTags = Tags(tags.IgnoreAWS())
 	}
@@ -433,6 +452,21 @@ func resourceCostCategoryRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.DiagError(names.CE, "setting rule", ResNameCostCategory, d.Id(), err)
 	}
 	d.Set("rule_version", costCategory.RuleVersion)
+
+	var diags diag.Diagnostics
+
+	// Rules matching on a deleted cost category no longer match anything, and the
+	// next update of the cost category fails.
+	if missing, err := findMissingCostCategoryReferences(ctx, conn, costCategory.Rules); err != nil {
+		log.Printf("[WARN] Checking Cost Explorer Cost Category (%s) rule references: %s", d.Id(), err)
+	} else if len(missing) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Cost Explorer Cost Category (%s) rules reference cost categories that don't exist", d.Id()),
+			Detail:   fmt.Sprintf("Rules match on cost categories %s, which don't exist. Update or remove these rules.", strings.Join(missing, ", ")),
+		})
+	}
+
 	if err = d.Set("split_charge_rule", flattenCostCategorySplitChargeRules(costCategory.SplitChargeRules)); err != nil {
 		return create.DiagError(names.CE, "setting split_charge_rule", ResNameCostCategory, d.Id(), err)
 	}
@@ -453,7 +487,24 @@ func resourceCostCategoryRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.DiagError(names.CE, "setting tags_all", ResNameCostCategory, d.Id(), err)
 	}
 
-	return nil
+	return diags
+}
+
+// findMissingCostCategoryReferences returns the names of the cost categories that
+// rules match on but that don't exist. Cost categories are only listed if rules
+// reference any.
+func findMissingCostCategoryReferences(ctx context.Context, conn *costexplorer.CostExplorer, rules []*costexplorer.CostCategoryRule) ([]string, error) {
+	if len(missingCostCategoryReferences(rules, nil)) == 0 {
+		return nil, nil
+	}
+
+	existing, err := FindCostCategoryDefinitions(ctx, conn, &costexplorer.ListCostCategoryDefinitionsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return missingCostCategoryReferences(rules, existing), nil
 }
 
 func resourceCostCategoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -477,6 +528,10 @@ func resourceCostCategoryUpdate(ctx context.Context, d *schema.ResourceData, met
 		_, err := conn.UpdateCostCategoryDefinitionWithContext(ctx, input)
 
 		if err != nil {
+			if missing, mErr := findMissingCostCategoryReferences(ctx, conn, input.Rules); mErr == nil && len(missing) > 0 {
+				err = fmt.Errorf("rules reference cost categories that don't exist: %s: %w", strings.Join(missing, ", "), err)
+			}
+
 			return create.DiagError(names.CE, create.ErrActionUpdating, ResNameCostCategory, d.Id(), err)
 		}
 	}
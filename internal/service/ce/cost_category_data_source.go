@@ -2,8 +2,13 @@ package ce
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,6 +21,9 @@ import (
 func DataSourceCostCategory() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceCostCategoryRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -32,6 +40,11 @@ func DataSourceCostCategory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"include_usage": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"time_period"},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -122,6 +135,50 @@ func DataSourceCostCategory() *schema.Resource {
 				},
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			"time_period": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"include_usage"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 40),
+						},
+						"start": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 40),
+						},
+					},
+				},
+			},
+			"value_usage": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amount": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"wait_for_processed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -315,12 +372,21 @@ func dataSourceCostCategoryRead(ctx context.Context, d *schema.ResourceData, met
 	conn := meta.(*conns.AWSClient).CEConn
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	costCategory, err := FindCostCategoryByARN(ctx, conn, d.Get("cost_category_arn").(string))
+	arn := d.Get("cost_category_arn").(string)
+	costCategory, err := FindCostCategoryByARN(ctx, conn, arn)
 
 	if err != nil {
 		return create.DiagError(names.CE, create.ErrActionReading, ResNameCostCategory, d.Id(), err)
 	}
 
+	if d.Get("wait_for_processed").(bool) {
+		costCategory, err = waitCostCategoryProcessed(ctx, conn, arn, d.Timeout(schema.TimeoutRead))
+
+		if err != nil {
+			return create.DiagError(names.CE, create.ErrActionWaitingForUpdate, ResNameCostCategory, arn, err)
+		}
+	}
+
 	d.Set("effective_end", costCategory.EffectiveEnd)
 	d.Set("effective_start", costCategory.EffectiveStart)
 	d.Set("name", costCategory.Name)
@@ -334,6 +400,20 @@ func dataSourceCostCategoryRead(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(aws.StringValue(costCategory.CostCategoryArn))
 
+	if d.Get("include_usage").(bool) {
+		usage, err := findCostCategoryValueUsage(ctx, conn, aws.StringValue(costCategory.Name), expandTagsTimePeriod(d.Get("time_period").([]interface{})[0].(map[string]interface{})))
+
+		if err != nil {
+			return create.DiagError(names.CE, "reading usage", ResNameCostCategory, d.Id(), err)
+		}
+
+		if err := d.Set("value_usage", usage); err != nil {
+			return create.DiagError(names.CE, "setting value_usage", ResNameCostCategory, d.Id(), err)
+		}
+	} else {
+		d.Set("value_usage", nil)
+	}
+
 	tags, err := ListTagsWithContext(ctx, conn, d.Id())
 
 	if err != nil {
@@ -346,3 +426,77 @@ func dataSourceCostCategoryRead(ctx context.Context, d *schema.ResourceData, met
 
 	return nil
 }
+
+// findCostCategoryValueUsage returns the unblended cost per value of the named
+// cost category over the given time period, summed across all result periods.
+func findCostCategoryValueUsage(ctx context.Context, conn *costexplorer.CostExplorer, name string, timePeriod *costexplorer.DateInterval) ([]interface{}, error) {
+	input := &costexplorer.GetCostAndUsageInput{
+		Granularity: aws.String(costexplorer.GranularityMonthly),
+		GroupBy: []*costexplorer.GroupDefinition{{
+			Key:  aws.String(name),
+			Type: aws.String(costexplorer.GroupDefinitionTypeCostCategory),
+		}},
+		Metrics:    aws.StringSlice([]string{costexplorer.MetricUnblendedCost}),
+		TimePeriod: timePeriod,
+	}
+
+	var values []string
+	amounts := make(map[string]float64)
+	units := make(map[string]string)
+
+	for {
+		output, err := conn.GetCostAndUsageWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range output.ResultsByTime {
+			for _, group := range result.Groups {
+				if len(group.Keys) == 0 {
+					continue
+				}
+
+				// Group keys have the form "<cost category name>$<value>".
+				value := strings.TrimPrefix(aws.StringValue(group.Keys[0]), name+"$")
+				metric, ok := group.Metrics[costexplorer.MetricUnblendedCost]
+
+				if !ok || metric == nil {
+					continue
+				}
+
+				amount, err := strconv.ParseFloat(aws.StringValue(metric.Amount), 64)
+
+				if err != nil {
+					return nil, err
+				}
+
+				if _, ok := units[value]; !ok {
+					values = append(values, value)
+				}
+
+				amounts[value] += amount
+				units[value] = aws.StringValue(metric.Unit)
+			}
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.NextPageToken = output.NextPageToken
+	}
+
+	sort.Strings(values)
+
+	tfList := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		tfList = append(tfList, map[string]interface{}{
+			"amount": strconv.FormatFloat(amounts[value], 'f', -1, 64),
+			"unit":   units[value],
+			"value":  value,
+		})
+	}
+
+	return tfList, nil
+}
@@ -35,6 +35,53 @@ func TestAccCECostCategoryDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccCECostCategoryDataSource_includeUsage(t *testing.T) {
+	var output costexplorer.CostCategory
+	resourceName := "aws_ce_cost_category.test"
+	dataSourceName := "data.aws_ce_cost_category.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryDataSourceConfig_includeUsage(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName, &output),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "include_usage", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "value_usage.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCECostCategoryDataSource_waitForProcessed(t *testing.T) {
+	var output costexplorer.CostCategory
+	resourceName := "aws_ce_cost_category.test"
+	dataSourceName := "data.aws_ce_cost_category.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryDataSourceConfig_waitForProcessed(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName, &output),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.%", resourceName, "rule.%"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCostCategoryDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(
 		testAccCostCategoryConfig_basic(rName),
@@ -44,3 +91,30 @@ data "aws_ce_cost_category" "test" {
 }
 `)
 }
+
+func testAccCostCategoryDataSourceConfig_includeUsage(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCostCategoryConfig_basic(rName),
+		`
+data "aws_ce_cost_category" "test" {
+  cost_category_arn = aws_ce_cost_category.test.arn
+  include_usage     = true
+
+  time_period {
+    start = "2022-01-01"
+    end   = "2022-02-01"
+  }
+}
+`)
+}
+
+func testAccCostCategoryDataSourceConfig_waitForProcessed(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCostCategoryConfig_basic(rName),
+		`
+data "aws_ce_cost_category" "test" {
+  cost_category_arn  = aws_ce_cost_category.test.arn
+  wait_for_processed = true
+}
+`)
+}
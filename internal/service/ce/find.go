@@ -10,30 +10,27 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// FindAnomalyMonitorByARN returns the anomaly monitor with the specified ARN.
+// Every page of results is searched, so that a monitor isn't reported as
+// missing because it's returned after the first page.
 func FindAnomalyMonitorByARN(ctx context.Context, conn *costexplorer.CostExplorer, arn string) (*costexplorer.AnomalyMonitor, error) {
 	in := &costexplorer.GetAnomalyMonitorsInput{
 		MonitorArnList: aws.StringSlice([]string{arn}),
-		MaxResults:     aws.Int64(1),
 	}
 
-	out, err := conn.GetAnomalyMonitorsWithContext(ctx, in)
-
-	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException) {
-		return nil, &resource.NotFoundError{
-			LastError:   err,
-			LastRequest: in,
-		}
-	}
+	monitors, err := FindAnomalyMonitors(ctx, conn, in)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if out == nil || len(out.AnomalyMonitors) == 0 || out.AnomalyMonitors[0] == nil {
-		return nil, tfresource.NewEmptyResultError(in)
+	for _, monitor := range monitors {
+		if aws.StringValue(monitor.MonitorArn) == arn {
+			return monitor, nil
+		}
 	}
 
-	return out.AnomalyMonitors[0], nil
+	return nil, tfresource.NewEmptyResultError(in)
 }
 
 func FindAnomalySubscriptionByARN(ctx context.Context, conn *costexplorer.CostExplorer, arn string) (*costexplorer.AnomalySubscription, error) {
@@ -44,7 +41,7 @@ func FindAnomalySubscriptionByARN(ctx context.Context, conn *costexplorer.CostEx
 
 	out, err := conn.GetAnomalySubscriptionsWithContext(ctx, in)
 
-	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException) {
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException, costexplorer.ErrCodeUnknownSubscriptionException) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: in,
@@ -112,3 +109,119 @@ func FindCostCategoryByARN(ctx context.Context, conn *costexplorer.CostExplorer,
 
 	return out.CostCategory, nil
 }
+
+// FindCostCategoryDefinitions returns references to all cost categories that
+// are effective at the time given in the input, which defaults to now.
+func FindCostCategoryDefinitions(ctx context.Context, conn *costexplorer.CostExplorer, in *costexplorer.ListCostCategoryDefinitionsInput) ([]*costexplorer.CostCategoryReference, error) {
+	var references []*costexplorer.CostCategoryReference
+
+	err := conn.ListCostCategoryDefinitionsPagesWithContext(ctx, in, func(page *costexplorer.ListCostCategoryDefinitionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, reference := range page.CostCategoryReferences {
+			if reference != nil {
+				references = append(references, reference)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return references, nil
+}
+
+// FindAnomalyMonitors returns all anomaly monitors matching the input,
+// following NextPageToken until the full list has been retrieved.
+func FindAnomalyMonitors(ctx context.Context, conn *costexplorer.CostExplorer, in *costexplorer.GetAnomalyMonitorsInput) ([]*costexplorer.AnomalyMonitor, error) {
+	var monitors []*costexplorer.AnomalyMonitor
+
+	for {
+		out, err := conn.GetAnomalyMonitorsWithContext(ctx, in)
+
+		if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if out == nil {
+			break
+		}
+
+		for _, monitor := range out.AnomalyMonitors {
+			if monitor != nil {
+				monitors = append(monitors, monitor)
+			}
+		}
+
+		if aws.StringValue(out.NextPageToken) == "" {
+			break
+		}
+
+		in.NextPageToken = out.NextPageToken
+	}
+
+	return monitors, nil
+}
+
+// FindAnomalyMonitorByName returns the anomaly monitor with the specified name.
+// Monitors can't be looked up by name, so every page of monitors is searched.
+// Names aren't unique, so more than one match is an error.
+func FindAnomalyMonitorByName(ctx context.Context, conn *costexplorer.CostExplorer, name string) (*costexplorer.AnomalyMonitor, error) {
+	in := &costexplorer.GetAnomalyMonitorsInput{}
+
+	monitors, err := FindAnomalyMonitors(ctx, conn, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*costexplorer.AnomalyMonitor
+
+	for _, monitor := range monitors {
+		if aws.StringValue(monitor.MonitorName) == name {
+			matches = append(matches, monitor)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if count := len(matches); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, in)
+	}
+
+	return matches[0], nil
+}
+
+// FindDimensionalAnomalyMonitor returns the account's DIMENSIONAL anomaly monitor.
+// An account can have at most one monitor of this type.
+func FindDimensionalAnomalyMonitor(ctx context.Context, conn *costexplorer.CostExplorer) (*costexplorer.AnomalyMonitor, error) {
+	in := &costexplorer.GetAnomalyMonitorsInput{}
+
+	monitors, err := FindAnomalyMonitors(ctx, conn, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, monitor := range monitors {
+		if aws.StringValue(monitor.MonitorType) == costexplorer.MonitorTypeDimensional {
+			return monitor, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
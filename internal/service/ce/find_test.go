@@ -0,0 +1,108 @@
+package ce
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// mockAnomalyMonitorsConn returns a client whose GetAnomalyMonitors calls return
+// the given pages, each linked to the next by its NextPageToken.
+func mockAnomalyMonitorsConn(t *testing.T, pages map[string]*costexplorer.GetAnomalyMonitorsOutput) (*costexplorer.CostExplorer, *int) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		Region:      aws.String("us-east-1"),
+	})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := costexplorer.New(sess)
+	calls := 0
+
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		calls++
+		page, ok := pages[aws.StringValue(r.Params.(*costexplorer.GetAnomalyMonitorsInput).NextPageToken)]
+
+		if !ok {
+			t.Fatalf("unexpected page token: %s", aws.StringValue(r.Params.(*costexplorer.GetAnomalyMonitorsInput).NextPageToken))
+		}
+
+		*r.Data.(*costexplorer.GetAnomalyMonitorsOutput) = *page
+	})
+
+	return conn, &calls
+}
+
+func TestFindAnomalyMonitorByARN(t *testing.T) {
+	const (
+		arn1 = "arn:aws:ce::123456789012:anomalymonitor/11111111-1111-1111-1111-111111111111"
+		arn2 = "arn:aws:ce::123456789012:anomalymonitor/22222222-2222-2222-2222-222222222222"
+	)
+
+	pages := map[string]*costexplorer.GetAnomalyMonitorsOutput{
+		"": {
+			AnomalyMonitors: []*costexplorer.AnomalyMonitor{{MonitorArn: aws.String(arn1)}},
+			NextPageToken:   aws.String("page-2"),
+		},
+		"page-2": {
+			NextPageToken: aws.String("page-3"),
+		},
+		"page-3": {
+			AnomalyMonitors: []*costexplorer.AnomalyMonitor{{MonitorArn: aws.String(arn2)}},
+		},
+	}
+
+	testCases := map[string]struct {
+		arn         string
+		expectFound bool
+	}{
+		"first page": {
+			arn:         arn1,
+			expectFound: true,
+		},
+		"later page": {
+			arn:         arn2,
+			expectFound: true,
+		},
+		"missing": {
+			arn: "arn:aws:ce::123456789012:anomalymonitor/33333333-3333-3333-3333-333333333333",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			conn, calls := mockAnomalyMonitorsConn(t, pages)
+
+			monitor, err := FindAnomalyMonitorByARN(context.Background(), conn, testCase.arn)
+
+			if *calls != len(pages) {
+				t.Errorf("got %d GetAnomalyMonitors calls, want %d", *calls, len(pages))
+			}
+
+			if !testCase.expectFound {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("got error %v, want a not found error", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := aws.StringValue(monitor.MonitorArn); got != testCase.arn {
+				t.Errorf("got %s, want %s", got, testCase.arn)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package ce
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Anomaly subscriptions have no status of their own.
+	anomalySubscriptionStatusAvailable = "Available"
+)
+
+// statusAnomalySubscription returns Available while the subscription can still
+// be read.
+func statusAnomalySubscription(ctx context.Context, conn *costexplorer.CostExplorer, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAnomalySubscriptionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, anomalySubscriptionStatusAvailable, nil
+	}
+}
+
+// statusCostCategoryProcessing returns PROCESSING while any component is still
+// processing the current cost category definition, and APPLIED otherwise.
+func statusCostCategoryProcessing(ctx context.Context, conn *costexplorer.CostExplorer, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCostCategoryByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output.ProcessingStatus {
+			if aws.StringValue(v.Status) == costexplorer.CostCategoryStatusProcessing {
+				return output, costexplorer.CostCategoryStatusProcessing, nil
+			}
+		}
+
+		return output, costexplorer.CostCategoryStatusApplied, nil
+	}
+}
@@ -0,0 +1,59 @@
+//go:build sweep
+// +build sweep
+
+package ce
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ce_anomaly_monitor", &resource.Sweeper{
+		Name: "aws_ce_anomaly_monitor",
+		F:    sweepAnomalyMonitors,
+	})
+}
+
+func sweepAnomalyMonitors(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).CEConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	monitors, err := FindAnomalyMonitors(context.Background(), conn, &costexplorer.GetAnomalyMonitorsInput{})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Cost Explorer Anomaly Monitor sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Cost Explorer Anomaly Monitors (%s): %w", region, err)
+	}
+
+	for _, monitor := range monitors {
+		r := ResourceAnomalyMonitor()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(monitor.MonitorArn))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Cost Explorer Anomaly Monitors (%s): %w", region, err)
+	}
+
+	return nil
+}
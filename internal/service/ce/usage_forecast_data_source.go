@@ -0,0 +1,226 @@
+package ce
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const forecastDateFormat = "2006-01-02"
+
+func DataSourceUsageForecast() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUsageForecastRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem:     schemaCostCategoryRule(),
+			},
+			"forecast_results_by_time": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mean_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prediction_interval_lower_bound": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prediction_interval_upper_bound": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"granularity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{costexplorer.GranularityDaily, costexplorer.GranularityMonthly}, false),
+			},
+			"metric": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{costexplorer.MetricUsageQuantity, costexplorer.MetricNormalizedUsageAmount}, false),
+			},
+			"prediction_interval_level": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(51, 99),
+			},
+			"time_period": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 40),
+						},
+						"start": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 40),
+						},
+					},
+				},
+			},
+			"total": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amount": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsageForecastRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn
+
+	timePeriod := expandTagsTimePeriod(d.Get("time_period").([]interface{})[0].(map[string]interface{}))
+
+	if err := validForecastTimePeriod(timePeriod, time.Now().UTC()); err != nil {
+		return create.DiagError(names.CE, create.ErrActionReading, DSNameUsageForecast, d.Id(), err)
+	}
+
+	input := &costexplorer.GetUsageForecastInput{
+		Granularity: aws.String(d.Get("granularity").(string)),
+		Metric:      aws.String(d.Get("metric").(string)),
+		TimePeriod:  timePeriod,
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filter = expandCostExpressions(v.([]interface{}))[0]
+	}
+
+	if v, ok := d.GetOk("prediction_interval_level"); ok {
+		input.PredictionIntervalLevel = aws.Int64(int64(v.(int)))
+	}
+
+	resp, err := conn.GetUsageForecastWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CE, create.ErrActionReading, DSNameUsageForecast, d.Id(), err)
+	}
+
+	if err := d.Set("forecast_results_by_time", flattenForecastResults(resp.ForecastResultsByTime)); err != nil {
+		return create.DiagSettingError(names.CE, DSNameUsageForecast, d.Id(), "forecast_results_by_time", err)
+	}
+
+	if err := d.Set("total", flattenMetricValue(resp.Total)); err != nil {
+		return create.DiagSettingError(names.CE, DSNameUsageForecast, d.Id(), "total", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return nil
+}
+
+// validForecastTimePeriod checks that a forecast period ends after it starts
+// and does not start in the past.
+func validForecastTimePeriod(apiObject *costexplorer.DateInterval, now time.Time) error {
+	start, err := time.Parse(forecastDateFormat, aws.StringValue(apiObject.Start))
+
+	if err != nil {
+		return fmt.Errorf("parsing time_period start: %w", err)
+	}
+
+	end, err := time.Parse(forecastDateFormat, aws.StringValue(apiObject.End))
+
+	if err != nil {
+		return fmt.Errorf("parsing time_period end: %w", err)
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("time_period end (%s) must be after start (%s)", aws.StringValue(apiObject.End), aws.StringValue(apiObject.Start))
+	}
+
+	if today := now.Truncate(24 * time.Hour); start.Before(today) {
+		return fmt.Errorf("time_period start (%s) must not be before the current date (%s)", aws.StringValue(apiObject.Start), today.Format(forecastDateFormat))
+	}
+
+	return nil
+}
+
+func flattenForecastResult(apiObject *costexplorer.ForecastResult) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"mean_value":                      aws.StringValue(apiObject.MeanValue),
+		"prediction_interval_lower_bound": aws.StringValue(apiObject.PredictionIntervalLowerBound),
+		"prediction_interval_upper_bound": aws.StringValue(apiObject.PredictionIntervalUpperBound),
+	}
+
+	if v := apiObject.TimePeriod; v != nil {
+		tfMap["end"] = aws.StringValue(v.End)
+		tfMap["start"] = aws.StringValue(v.Start)
+	}
+
+	return tfMap
+}
+
+func flattenForecastResults(apiObjects []*costexplorer.ForecastResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenForecastResult(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenMetricValue(apiObject *costexplorer.MetricValue) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"amount": aws.StringValue(apiObject.Amount),
+		"unit":   aws.StringValue(apiObject.Unit),
+	}}
+}
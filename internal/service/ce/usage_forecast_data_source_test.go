@@ -0,0 +1,77 @@
+package ce_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCEUsageForecastDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ce_usage_forecast.test"
+
+	formatDate := "2006-01-02"
+	currentTime := time.Now().UTC()
+	startDate := currentTime.Format(formatDate)
+	endDate := currentTime.AddDate(0, 1, 0).Format(formatDate)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageForecastDataSourceConfig_basic(startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "total.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total.0.amount"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "forecast_results_by_time.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEUsageForecastDataSource_pastStartDate(t *testing.T) {
+	formatDate := "2006-01-02"
+	currentTime := time.Now().UTC()
+	startDate := currentTime.AddDate(0, -1, 0).Format(formatDate)
+	endDate := currentTime.AddDate(0, 1, 0).Format(formatDate)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUsageForecastDataSourceConfig_basic(startDate, endDate),
+				ExpectError: regexp.MustCompile(`must not be before the current date`),
+			},
+		},
+	})
+}
+
+func testAccUsageForecastDataSourceConfig_basic(start, end string) string {
+	return fmt.Sprintf(`
+data "aws_ce_usage_forecast" "test" {
+  granularity = "MONTHLY"
+  metric      = "USAGE_QUANTITY"
+
+  filter {
+    dimension {
+      key    = "USAGE_TYPE_GROUP"
+      values = ["EC2: Data Transfer - Internet (Out)"]
+    }
+  }
+
+  time_period {
+    start = %[1]q
+    end   = %[2]q
+  }
+}
+`, start, end)
+}
@@ -0,0 +1,394 @@
+package ce
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/go-multierror"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// ValidateAnomalyMonitorSpecification checks that a CUSTOM monitor specification
+// is a JSON Expression object and that every dimension key in it is one that Cost
+// Explorer supports. Specifications are often loaded with file(), so JSON errors
+// include the line and column they were found at. All unsupported dimension keys
+// are reported together.
+func ValidateAnomalyMonitorSpecification(value string) error {
+	var errs *multierror.Error
+
+	for _, err := range anomalyMonitorSpecificationErrors(value) {
+		errs = multierror.Append(errs, err)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// validAnomalyMonitorSpecification is the ValidateFunc counterpart of
+// ValidateAnomalyMonitorSpecification.
+func validAnomalyMonitorSpecification(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, err := range anomalyMonitorSpecificationErrors(value) {
+		errors = append(errors, fmt.Errorf("%s: %w", k, err))
+	}
+
+	return
+}
+
+// validAnomalyMonitorARN checks that a value is the ARN of a Cost Explorer
+// anomaly monitor, rather than of a subscription or another resource.
+func validAnomalyMonitorARN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	parsed, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s (%s) is not a valid ARN: %w", k, value, err))
+		return
+	}
+
+	if parsed.Service != costexplorer.ServiceName || !strings.HasPrefix(parsed.Resource, "anomalymonitor/") {
+		errors = append(errors, fmt.Errorf("%s (%s) is not a Cost Explorer anomaly monitor ARN", k, value))
+	}
+
+	return
+}
+
+func anomalyMonitorSpecificationErrors(value string) []error {
+	var expression costexplorer.Expression
+
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&expression); err != nil {
+		return []error{fmt.Errorf("not a valid Cost Explorer Expression: %w", jsonErrorWithLocation(value, err))}
+	}
+
+	// More() doesn't report a stray closing bracket, so require the end of input.
+	if _, err := decoder.Token(); err != io.EOF {
+		return []error{fmt.Errorf("not a valid Cost Explorer Expression: unexpected data after the top-level JSON object")}
+	}
+
+	return validExpressionDimensionKeys(&expression, "")
+}
+
+func validExpressionDimensionKeys(apiObject *costexplorer.Expression, path string) []error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if v := apiObject.Dimensions; v != nil && v.Key != nil {
+		key := aws.StringValue(v.Key)
+		supported := false
+
+		for _, dimension := range costexplorer.Dimension_Values() {
+			if key == dimension {
+				supported = true
+				break
+			}
+		}
+
+		if !supported {
+			errs = append(errs, fmt.Errorf("unsupported dimension key %q at %sDimensions.Key, expected one of: %s", key, path, strings.Join(costexplorer.Dimension_Values(), ", ")))
+		}
+	}
+
+	for i, v := range apiObject.And {
+		errs = append(errs, validExpressionDimensionKeys(v, fmt.Sprintf("%sAnd[%d].", path, i))...)
+	}
+
+	for i, v := range apiObject.Or {
+		errs = append(errs, validExpressionDimensionKeys(v, fmt.Sprintf("%sOr[%d].", path, i))...)
+	}
+
+	errs = append(errs, validExpressionDimensionKeys(apiObject.Not, path+"Not.")...)
+
+	return errs
+}
+
+const (
+	anomalySubscriptionEmailSubscribersMax = 10
+	anomalySubscriptionSNSSubscribersMax   = 1
+)
+
+// validAnomalySubscriptionSubscribers checks a subscriber set against the Cost
+// Explorer limits of 10 email recipients and 1 SNS topic per subscription, and
+// rejects addresses listed more than once. The set only removes exact duplicates,
+// so addresses are compared trimmed and, for email, case-insensitively.
+// Addresses not yet known are skipped.
+func validAnomalySubscriptionSubscribers(tfList []interface{}) error {
+	counts := make(map[string]int)
+	seen := make(map[string]bool)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		subscriberType, _ := tfMap["type"].(string)
+		address, _ := tfMap["address"].(string)
+		address = strings.TrimSpace(address)
+
+		if subscriberType == "" || address == "" {
+			continue
+		}
+
+		counts[subscriberType]++
+
+		key := address
+		if subscriberType == costexplorer.SubscriberTypeEmail {
+			key = strings.ToLower(address)
+		}
+		key = subscriberType + ":" + key
+
+		if seen[key] {
+			return fmt.Errorf("subscriber: duplicate %s subscriber address %q", subscriberType, address)
+		}
+		seen[key] = true
+	}
+
+	if n := counts[costexplorer.SubscriberTypeEmail]; n > anomalySubscriptionEmailSubscribersMax {
+		return fmt.Errorf("subscriber: %d %s subscribers configured, a subscription supports at most %d", n, costexplorer.SubscriberTypeEmail, anomalySubscriptionEmailSubscribersMax)
+	}
+
+	if n := counts[costexplorer.SubscriberTypeSns]; n > anomalySubscriptionSNSSubscribersMax {
+		return fmt.Errorf("subscriber: %d %s subscribers configured, a subscription supports at most %d", n, costexplorer.SubscriberTypeSns, anomalySubscriptionSNSSubscribersMax)
+	}
+
+	return nil
+}
+
+const (
+	anomalySubscriptionSNSServicePrincipal = "costalerts.amazonaws.com"
+)
+
+// validAnomalySubscriptionTopicPolicy checks that an SNS topic access policy
+// allows Cost Anomaly Detection to publish to the topic. Without that statement
+// Cost Explorer accepts the subscriber but notifications are silently dropped.
+// Conditions on Allow statements aren't evaluated, and Deny statements are only
+// honored when they are unconditional.
+func validAnomalySubscriptionTopicPolicy(policy string) error {
+	var doc tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return fmt.Errorf("parsing SNS topic policy: %w", err)
+	}
+
+	allowed := false
+
+	for _, statement := range doc.Statements {
+		if statement == nil || !policyStatementMatchesPublish(statement) {
+			continue
+		}
+
+		switch {
+		case strings.EqualFold(statement.Effect, "Deny") && len(statement.Conditions) == 0:
+			return fmt.Errorf("SNS topic policy denies %s the sns:Publish action", anomalySubscriptionSNSServicePrincipal)
+		case strings.EqualFold(statement.Effect, "Allow"):
+			allowed = true
+		}
+	}
+
+	if !allowed {
+		return fmt.Errorf("SNS topic policy does not allow %s the sns:Publish action", anomalySubscriptionSNSServicePrincipal)
+	}
+
+	return nil
+}
+
+func policyStatementMatchesPublish(statement *tfiam.IAMPolicyStatement) bool {
+	actionMatched := false
+
+	for _, action := range policyStringList(statement.Actions) {
+		if action == "*" || strings.EqualFold(action, "sns:*") || strings.EqualFold(action, "sns:Publish") {
+			actionMatched = true
+			break
+		}
+	}
+
+	if !actionMatched {
+		return false
+	}
+
+	for _, principal := range statement.Principals {
+		for _, identifier := range policyStringList(principal.Identifiers) {
+			switch {
+			case identifier == "*" && (principal.Type == "*" || principal.Type == "AWS"):
+				return true
+			case principal.Type == "Service" && identifier == anomalySubscriptionSNSServicePrincipal:
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func policyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var values []string
+		for _, e := range v {
+			if e, ok := e.(string); ok {
+				values = append(values, e)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// jsonErrorWithLocation adds the line and column of a JSON decoding error.
+func jsonErrorWithLocation(value string, err error) error {
+	var offset int64
+
+	switch err := err.(type) {
+	case *json.SyntaxError:
+		offset = err.Offset
+	case *json.UnmarshalTypeError:
+		offset = err.Offset
+	default:
+		return err
+	}
+
+	line, column := 1, 1
+	for i, r := range value {
+		if int64(i) >= offset {
+			break
+		}
+
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+
+	return fmt.Errorf("line %d, column %d: %w", line, column, err)
+}
+
+// validCostCategoryRules checks the fields each cost category rule needs for its
+// type. A REGULAR rule (the default) maps an expression to a value, while an
+// INHERITED_VALUE rule takes its value from a dimension. Every offending rule is
+// reported together, by its index in the configured set.
+func validCostCategoryRules(tfList []interface{}) error {
+	var errs *multierror.Error
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		ruleType, _ := tfMap["type"].(string)
+		value, _ := tfMap["value"].(string)
+		expressions, _ := tfMap["rule"].([]interface{})
+		inheritedValues, _ := tfMap["inherited_value"].([]interface{})
+		hasExpression := len(expressions) > 0 && expressions[0] != nil
+		hasInheritedValue := len(inheritedValues) > 0 && inheritedValues[0] != nil
+
+		switch ruleType {
+		case "", costexplorer.CostCategoryRuleTypeRegular:
+			if value == "" {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: value is required for a %s rule", i, costexplorer.CostCategoryRuleTypeRegular))
+			}
+			if !hasExpression {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: rule is required for a %s rule", i, costexplorer.CostCategoryRuleTypeRegular))
+			}
+			if hasInheritedValue {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: inherited_value can only be set for an %s rule", i, costexplorer.CostCategoryRuleTypeInheritedValue))
+			}
+		case costexplorer.CostCategoryRuleTypeInheritedValue:
+			if !hasInheritedValue {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: inherited_value is required for an %s rule", i, costexplorer.CostCategoryRuleTypeInheritedValue))
+			}
+			if value != "" {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: value can't be set for an %s rule, it is taken from inherited_value", i, costexplorer.CostCategoryRuleTypeInheritedValue))
+			}
+			if hasExpression {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: rule can't be set for an %s rule", i, costexplorer.CostCategoryRuleTypeInheritedValue))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// missingCostCategoryReferences returns the sorted names of the cost categories
+// that rules match on but that aren't among the existing cost categories.
+func missingCostCategoryReferences(rules []*costexplorer.CostCategoryRule, existing []*costexplorer.CostCategoryReference) []string {
+	names := make(map[string]bool)
+	for _, reference := range existing {
+		names[aws.StringValue(reference.Name)] = true
+	}
+
+	missing := make(map[string]bool)
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		for _, key := range costCategoryExpressionKeys(rule.Rule) {
+			if !names[key] {
+				missing[key] = true
+			}
+		}
+	}
+
+	var result []string
+	for key := range missing {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// costCategoryExpressionKeys returns the cost category names an expression
+// matches on, including those in nested And, Or and Not expressions.
+func costCategoryExpressionKeys(apiObject *costexplorer.Expression) []string {
+	if apiObject == nil {
+		return nil
+	}
+
+	var keys []string
+
+	if apiObject.CostCategories != nil && aws.StringValue(apiObject.CostCategories.Key) != "" {
+		keys = append(keys, aws.StringValue(apiObject.CostCategories.Key))
+	}
+
+	for _, v := range apiObject.And {
+		keys = append(keys, costCategoryExpressionKeys(v)...)
+	}
+
+	for _, v := range apiObject.Or {
+		keys = append(keys, costCategoryExpressionKeys(v)...)
+	}
+
+	keys = append(keys, costCategoryExpressionKeys(apiObject.Not)...)
+
+	return keys
+}
@@ -0,0 +1,431 @@
+package ce
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidAnomalyMonitorSpecification(t *testing.T) {
+	testCases := map[string]struct {
+		value       string
+		expectError string
+	}{
+		"valid dimension": {
+			value: `{"Dimensions":{"Key":"LINKED_ACCOUNT","Values":["123456789012"]}}`,
+		},
+		"tags only": {
+			value: `{"Tags":{"Key":"CostCenter","Values":["10000"]}}`,
+		},
+		"invalid dimension": {
+			value:       `{"Dimensions":{"Key":"ACCOUNT","Values":["123456789012"]}}`,
+			expectError: `unsupported dimension key "ACCOUNT" at Dimensions.Key`,
+		},
+		"nested invalid dimension": {
+			value:       `{"Or":[{"Tags":{"Key":"CostCenter","Values":["10000"]}},{"Not":{"Dimensions":{"Key":"SERVICES","Values":["Amazon S3"]}}}]}`,
+			expectError: `unsupported dimension key "SERVICES" at Or[1].Not.Dimensions.Key`,
+		},
+		"malformed": {
+			value:       "{\n  \"Dimensions\": {\n    \"Key\": \"LINKED_ACCOUNT\",\n  }\n}",
+			expectError: "line 4, column",
+		},
+		"wrong shape": {
+			value:       `{"Dimensions":{"Key":"LINKED_ACCOUNT","Values":"123456789012"}}`,
+			expectError: "line 1, column",
+		},
+		"trailing closing brace": {
+			value:       `{"Tags":{"Key":"CostCenter","Values":["10000"]}}}`,
+			expectError: "unexpected data after the top-level JSON object",
+		},
+		"trailing object": {
+			value:       `{"Tags":{"Key":"CostCenter","Values":["10000"]}} {}`,
+			expectError: "unexpected data after the top-level JSON object",
+		},
+		"trailing whitespace": {
+			value: "{\"Tags\":{\"Key\":\"CostCenter\",\"Values\":[\"10000\"]}}\n",
+		},
+		"unknown field": {
+			value:       `{"Dimension":{"Key":"LINKED_ACCOUNT","Values":["123456789012"]}}`,
+			expectError: `unknown field "Dimension"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			_, errs := validAnomalyMonitorSpecification(testCase.value, "monitor_specification")
+
+			if testCase.expectError == "" {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected errors: %v", errs)
+				}
+				return
+			}
+
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error, got: %v", errs)
+			}
+
+			if !strings.Contains(errs[0].Error(), testCase.expectError) {
+				t.Errorf("error %q does not contain %q", errs[0], testCase.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateAnomalyMonitorSpecification(t *testing.T) {
+	if err := ValidateAnomalyMonitorSpecification(`{"Dimensions":{"Key":"LINKED_ACCOUNT","Values":["123456789012"]}}`); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err := ValidateAnomalyMonitorSpecification(`{"And":[{"Dimensions":{"Key":"ACCOUNT","Values":["123456789012"]}},{"Dimensions":{"Key":"SERVICES","Values":["Amazon S3"]}}]}`)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	for _, expected := range []string{`unsupported dimension key "ACCOUNT" at And[0].Dimensions.Key`, `unsupported dimension key "SERVICES" at And[1].Dimensions.Key`} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("error %q does not contain %q", err, expected)
+		}
+	}
+
+	if err := ValidateAnomalyMonitorSpecification(`{"Dimensions":`); err == nil || !strings.Contains(err.Error(), "not a valid Cost Explorer Expression") {
+		t.Errorf("expected invalid Expression error, got: %v", err)
+	}
+}
+
+func TestValidAnomalySubscriptionSubscribers(t *testing.T) {
+	subscriber := func(subscriberType, address string) interface{} {
+		return map[string]interface{}{"type": subscriberType, "address": address}
+	}
+
+	emails := func(n int) []interface{} {
+		var tfList []interface{}
+		for i := 0; i < n; i++ {
+			tfList = append(tfList, subscriber("EMAIL", fmt.Sprintf("user%d@example.com", i)))
+		}
+		return tfList
+	}
+
+	testCases := map[string]struct {
+		value       []interface{}
+		expectError string
+	}{
+		"empty": {},
+		"email and sns": {
+			value: []interface{}{
+				subscriber("EMAIL", "user@example.com"),
+				subscriber("SNS", "arn:aws:sns:us-east-1:123456789012:topic"),
+			},
+		},
+		"max emails": {
+			value: emails(10),
+		},
+		"too many emails": {
+			value:       emails(11),
+			expectError: "11 EMAIL subscribers configured, a subscription supports at most 10",
+		},
+		"too many sns": {
+			value: []interface{}{
+				subscriber("SNS", "arn:aws:sns:us-east-1:123456789012:topic1"),
+				subscriber("SNS", "arn:aws:sns:us-east-1:123456789012:topic2"),
+			},
+			expectError: "2 SNS subscribers configured, a subscription supports at most 1",
+		},
+		"duplicate email differing in case": {
+			value: []interface{}{
+				subscriber("EMAIL", "user@example.com"),
+				subscriber("EMAIL", "User@Example.com"),
+			},
+			expectError: `duplicate EMAIL subscriber address "User@Example.com"`,
+		},
+		"duplicate sns differing in whitespace": {
+			value: []interface{}{
+				subscriber("SNS", "arn:aws:sns:us-east-1:123456789012:topic"),
+				subscriber("SNS", " arn:aws:sns:us-east-1:123456789012:topic"),
+			},
+			expectError: "duplicate SNS subscriber address",
+		},
+		"unknown address": {
+			value: []interface{}{
+				subscriber("SNS", ""),
+				subscriber("SNS", "arn:aws:sns:us-east-1:123456789012:topic"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			err := validAnomalySubscriptionSubscribers(testCase.value)
+
+			if testCase.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.expectError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expectError) {
+				t.Errorf("error %q does not contain %q", err, testCase.expectError)
+			}
+		})
+	}
+}
+
+func TestValidAnomalySubscriptionTopicPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		value       string
+		expectError string
+	}{
+		"service principal": {
+			value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"costalerts.amazonaws.com"},"Action":"SNS:Publish","Resource":"arn:aws:sns:us-east-1:123456789012:topic"}]}`,
+		},
+		"service principal list and action list": {
+			value: `{"Statement":[{"Effect":"Allow","Principal":{"Service":["sns.amazonaws.com","costalerts.amazonaws.com"]},"Action":["sns:Subscribe","sns:Publish"],"Resource":"*"}]}`,
+		},
+		"wildcard principal with condition": {
+			value: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"sns:*","Resource":"*","Condition":{"StringEquals":{"AWS:SourceOwner":"123456789012"}}}]}`,
+		},
+		"conditional deny": {
+			value: `{"Statement":[{"Effect":"Allow","Principal":{"Service":"costalerts.amazonaws.com"},"Action":"sns:Publish","Resource":"*"},{"Effect":"Deny","Principal":"*","Action":"sns:Publish","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+		},
+		"default policy": {
+			value:       `{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["SNS:GetTopicAttributes","SNS:Publish"],"Resource":"arn:aws:sns:us-east-1:123456789012:topic"}]}`,
+			expectError: "does not allow costalerts.amazonaws.com the sns:Publish action",
+		},
+		"other action": {
+			value:       `{"Statement":[{"Effect":"Allow","Principal":{"Service":"costalerts.amazonaws.com"},"Action":"sns:Subscribe","Resource":"*"}]}`,
+			expectError: "does not allow",
+		},
+		"unconditional deny": {
+			value:       `{"Statement":[{"Effect":"Allow","Principal":{"Service":"costalerts.amazonaws.com"},"Action":"sns:Publish","Resource":"*"},{"Effect":"Deny","Principal":{"Service":"costalerts.amazonaws.com"},"Action":"sns:Publish","Resource":"*"}]}`,
+			expectError: "denies costalerts.amazonaws.com the sns:Publish action",
+		},
+		"empty": {
+			value:       "",
+			expectError: "parsing SNS topic policy",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			err := validAnomalySubscriptionTopicPolicy(testCase.value)
+
+			if testCase.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.expectError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expectError) {
+				t.Errorf("error %q does not contain %q", err, testCase.expectError)
+			}
+		})
+	}
+}
+
+func TestValidCostCategoryRules(t *testing.T) {
+	expression := []interface{}{map[string]interface{}{}}
+	inheritedValue := []interface{}{map[string]interface{}{"dimension_name": "TAG", "dimension_key": "CostCenter"}}
+
+	testCases := map[string]struct {
+		value       []interface{}
+		expectError []string
+	}{
+		"empty": {},
+		"regular": {
+			value: []interface{}{
+				map[string]interface{}{"type": "REGULAR", "value": "production", "rule": expression},
+			},
+		},
+		"default type": {
+			value: []interface{}{
+				map[string]interface{}{"value": "production", "rule": expression},
+			},
+		},
+		"inherited value": {
+			value: []interface{}{
+				map[string]interface{}{"type": "INHERITED_VALUE", "inherited_value": inheritedValue},
+			},
+		},
+		"regular missing value and rule": {
+			value: []interface{}{
+				map[string]interface{}{"type": "REGULAR", "value": "production", "rule": expression},
+				map[string]interface{}{"type": "REGULAR"},
+			},
+			expectError: []string{
+				"rule 1: value is required for a REGULAR rule",
+				"rule 1: rule is required for a REGULAR rule",
+			},
+		},
+		"regular with inherited value": {
+			value: []interface{}{
+				map[string]interface{}{"value": "production", "rule": expression, "inherited_value": inheritedValue},
+			},
+			expectError: []string{"rule 0: inherited_value can only be set for an INHERITED_VALUE rule"},
+		},
+		"inherited value missing dimension": {
+			value: []interface{}{
+				map[string]interface{}{"type": "INHERITED_VALUE"},
+			},
+			expectError: []string{"rule 0: inherited_value is required for an INHERITED_VALUE rule"},
+		},
+		"inherited value with value and rule": {
+			value: []interface{}{
+				map[string]interface{}{"type": "INHERITED_VALUE", "inherited_value": inheritedValue, "value": "production", "rule": expression},
+			},
+			expectError: []string{
+				"rule 0: value can't be set for an INHERITED_VALUE rule",
+				"rule 0: rule can't be set for an INHERITED_VALUE rule",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			err := validCostCategoryRules(testCase.value)
+
+			if len(testCase.expectError) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected errors %q, got none", testCase.expectError)
+			}
+
+			for _, expected := range testCase.expectError {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("error %q does not contain %q", err, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestValidAnomalyMonitorARN(t *testing.T) {
+	validARNs := []string{
+		"arn:aws:ce::123456789012:anomalymonitor/12345678-1234-1234-1234-123456789012",
+	}
+	for _, v := range validARNs {
+		if _, errors := validAnomalyMonitorARN(v, "arn"); len(errors) != 0 {
+			t.Errorf("%q should be a valid anomaly monitor ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"12345678-1234-1234-1234-123456789012",
+		"arn:aws:ce::123456789012:anomalysubscription/12345678-1234-1234-1234-123456789012",
+		"arn:aws:sns:us-east-1:123456789012:anomalymonitor/test",
+	}
+	for _, v := range invalidARNs {
+		if _, errors := validAnomalyMonitorARN(v, "arn"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid anomaly monitor ARN", v)
+		}
+	}
+}
+
+func TestMissingCostCategoryReferences(t *testing.T) {
+	costCategory := func(key string) *costexplorer.Expression {
+		return &costexplorer.Expression{
+			CostCategories: &costexplorer.CostCategoryValues{Key: aws.String(key), Values: aws.StringSlice([]string{"value"})},
+		}
+	}
+	existing := []*costexplorer.CostCategoryReference{
+		{Name: aws.String("present")},
+	}
+
+	testCases := map[string]struct {
+		rules    []*costexplorer.CostCategoryRule
+		expected []string
+	}{
+		"no references": {
+			rules: []*costexplorer.CostCategoryRule{
+				{Rule: &costexplorer.Expression{Dimensions: &costexplorer.DimensionValues{Key: aws.String("LINKED_ACCOUNT")}}},
+			},
+		},
+		"existing reference": {
+			rules: []*costexplorer.CostCategoryRule{
+				{Rule: costCategory("present")},
+			},
+		},
+		"nested missing references": {
+			rules: []*costexplorer.CostCategoryRule{
+				{Rule: &costexplorer.Expression{
+					And: []*costexplorer.Expression{
+						costCategory("present"),
+						{Not: costCategory("deleted2")},
+					},
+				}},
+				{Rule: &costexplorer.Expression{
+					Or: []*costexplorer.Expression{costCategory("deleted1"), costCategory("deleted2")},
+				}},
+				{Type: aws.String(costexplorer.CostCategoryRuleTypeInheritedValue)},
+			},
+			expected: []string{"deleted1", "deleted2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			got := missingCostCategoryReferences(testCase.rules, existing)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAnomalyMonitorAccountScope(t *testing.T) {
+	tfMap := map[string]interface{}{
+		"linked_account_ids": schema.NewSet(schema.HashString, []interface{}{"210987654321", "123456789012"}),
+	}
+
+	apiObject := expandAnomalyMonitorAccountScope(tfMap)
+
+	if got, want := aws.StringValueSlice(apiObject.Dimensions.Values), []string{"123456789012", "210987654321"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values = %v, want %v", got, want)
+	}
+
+	// Cost Explorer may fill in match options, which mustn't cause drift.
+	apiObject.Dimensions.MatchOptions = aws.StringSlice([]string{costexplorer.MatchOptionEquals})
+
+	flattened := flattenAnomalyMonitorAccountScope(apiObject)
+
+	if flattened == nil || !flattened["linked_account_ids"].(*schema.Set).Equal(tfMap["linked_account_ids"]) {
+		t.Errorf("round trip = %v, want %v", flattened, tfMap)
+	}
+
+	for name, apiObject := range map[string]*costexplorer.Expression{
+		"nil":           nil,
+		"other key":     {Dimensions: &costexplorer.DimensionValues{Key: aws.String(costexplorer.DimensionService), Values: aws.StringSlice([]string{"Amazon EC2"})}},
+		"tags":          {Tags: &costexplorer.TagValues{Key: aws.String("CostCenter")}},
+		"combined with": {Dimensions: &costexplorer.DimensionValues{Key: aws.String(costexplorer.DimensionLinkedAccount), Values: aws.StringSlice([]string{"123456789012"})}, Not: &costexplorer.Expression{}},
+	} {
+		if got := flattenAnomalyMonitorAccountScope(apiObject); got != nil {
+			t.Errorf("%s: flattenAnomalyMonitorAccountScope = %v, want nil", name, got)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package ce
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	anomalyMonitorCreatedTimeout      = 2 * time.Minute
+	anomalySubscriptionCreatedTimeout = 2 * time.Minute
+	anomalySubscriptionDeletedTimeout = 2 * time.Minute
+)
+
+// WaitAnomalyMonitorAvailable waits for a newly created anomaly monitor to be
+// returned by GetAnomalyMonitors, which can lag behind CreateAnomalyMonitor.
+func WaitAnomalyMonitorAvailable(ctx context.Context, conn *costexplorer.CostExplorer, arn string, timeout time.Duration) (*costexplorer.AnomalyMonitor, error) {
+	outputRaw, err := tfresource.RetryWhenNotFoundContext(ctx, timeout, func() (interface{}, error) {
+		return FindAnomalyMonitorByARN(ctx, conn, arn)
+	})
+
+	if output, ok := outputRaw.(*costexplorer.AnomalyMonitor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WaitAnomalySubscriptionAvailable waits for a newly created anomaly subscription
+// to be returned by GetAnomalySubscriptions, which can lag behind
+// CreateAnomalySubscription.
+func WaitAnomalySubscriptionAvailable(ctx context.Context, conn *costexplorer.CostExplorer, arn string, timeout time.Duration) (*costexplorer.AnomalySubscription, error) {
+	outputRaw, err := tfresource.RetryWhenNotFoundContext(ctx, timeout, func() (interface{}, error) {
+		return FindAnomalySubscriptionByARN(ctx, conn, arn)
+	})
+
+	if output, ok := outputRaw.(*costexplorer.AnomalySubscription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WaitAnomalySubscriptionDeleted waits for a deleted anomaly subscription to no
+// longer be returned by GetAnomalySubscriptions.
+func WaitAnomalySubscriptionDeleted(ctx context.Context, conn *costexplorer.CostExplorer, arn string, timeout time.Duration) (*costexplorer.AnomalySubscription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{anomalySubscriptionStatusAvailable},
+		Target:  []string{},
+		Refresh: statusAnomalySubscription(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*costexplorer.AnomalySubscription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCostCategoryProcessed(ctx context.Context, conn *costexplorer.CostExplorer, arn string, timeout time.Duration) (*costexplorer.CostCategory, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{costexplorer.CostCategoryStatusProcessing},
+		Target:  []string{costexplorer.CostCategoryStatusApplied},
+		Refresh: statusCostCategoryProcessing(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*costexplorer.CostCategory); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -1,16 +1,22 @@
 package neptune
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -24,7 +30,13 @@ func ResourceClusterEndpoint() *schema.Resource {
 		Update: resourceClusterEndpointUpdate,
 		Delete: resourceClusterEndpointDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceClusterEndpointImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DBClusterEndpointAvailableTimeout),
+			Update: schema.DefaultTimeout(DBClusterEndpointAvailableTimeout),
+			Delete: schema.DefaultTimeout(DBClusterEndpointDeletedTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -32,6 +44,15 @@ func ResourceClusterEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"authoritative_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"cluster_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"cluster_identifier": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -39,10 +60,46 @@ func ResourceClusterEndpoint() *schema.Resource {
 				ValidateFunc: validIdentifier,
 			},
 			"cluster_endpoint_identifier": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validIdentifier,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cluster_endpoint_identifier_prefix"},
+				ValidateFunc:  validIdentifier,
+			},
+			"cluster_endpoint_identifier_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cluster_endpoint_identifier"},
+				ValidateFunc:  validIdentifierPrefix,
+			},
+			"cluster_reader_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_writer_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_subnet_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detect_stale_static_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"effective_members": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
 			},
 			"endpoint": {
 				Type:     schema.TypeString,
@@ -51,25 +108,309 @@ func ResourceClusterEndpoint() *schema.Resource {
 			"endpoint_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"READER", "WRITER", "ANY"}, false),
+				ValidateFunc: validation.StringInSlice(clusterEndpointType_Values(), false),
 			},
-			"static_members": {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plan_effective_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"skip_create_wait": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
+			},
+			"skip_delete_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"static_members": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"static_members_ordered"},
+			},
+			"static_members_ordered": {
+				Type:          schema.TypeList,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"static_members"},
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"excluded_members": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
 			},
+			"iam_database_authentication_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"validate_effective_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"validate_member_roles": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceClusterEndpointCustomizeDiffTags,
+			resourceClusterEndpointCustomizeDiffMemberRoles,
+			resourceClusterEndpointCustomizeDiffEmptyStaticMembers,
+			resourceClusterEndpointCustomizeDiffOverlappingMembers,
+			resourceClusterEndpointCustomizeDiffEffectiveMembers,
+			resourceClusterEndpointCustomizeDiffLastModifiedTime,
+			resourceClusterEndpointCustomizeDiffPlanEffectiveMembers,
+		),
+	}
+}
+
+// clusterEndpointAuthoritativeMembers returns whether Terraform owns the full
+// member lists. State that was imported or written before authoritative_members
+// existed has no value for it, and gets the default.
+func clusterEndpointAuthoritativeMembers(d *schema.ResourceData) bool {
+	if rawState := d.GetRawState(); !rawState.IsNull() && rawState.IsKnown() && rawState.Type().HasAttribute("authoritative_members") {
+		if rawState.GetAttr("authoritative_members").IsNull() {
+			return true
+		}
+	}
+
+	return d.Get("authoritative_members").(bool)
+}
+
+// resourceClusterEndpointCustomizeDiffLastModifiedTime plans last_modified_time
+// as unknown whenever the update will modify the endpoint. DBClusterEndpoint has
+// no modification timestamp, so this is the time of Terraform's own last change
+// and out-of-band modifications aren't reflected.
+func resourceClusterEndpointCustomizeDiffLastModifiedTime(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	keys := []string{"endpoint_type", "excluded_members", "static_members", "static_members_ordered"}
+	if clusterEndpointTagsSupported(meta.(*conns.AWSClient).Partition) {
+		keys = append(keys, "tags_all")
+	}
+
+	if !diff.HasChanges(keys...) {
+		return nil
+	}
+
+	if err := diff.SetNewComputed("last_modified_time"); err != nil {
+		return fmt.Errorf("setting last_modified_time to computed: %w", err)
+	}
+
+	return nil
+}
+
+// resourceClusterEndpointCustomizeDiffTags wraps verify.SetTagsDiff. When any tags
+// value is unknown until apply, SDK v2 treats the whole tags map as unknown, so
+// with provider default_tags SetTagsDiff would plan tags_all from the default
+// tags alone and apply would then contradict that plan. tags_all is left unknown
+// instead. SDK v2 can't mark individual map keys unknown from CustomizeDiff.
+func resourceClusterEndpointCustomizeDiffTags(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if len(meta.(*conns.AWSClient).DefaultTagsConfig.GetTags()) > 0 && !tagsWhollyKnown(diff.GetRawConfig()) {
+		if err := diff.SetNewComputed("tags_all"); err != nil {
+			return fmt.Errorf("setting tags_all to computed: %w", err)
+		}
+
+		return nil
+	}
+
+	return verify.SetTagsDiff(ctx, diff, meta)
+}
+
+// resourceClusterEndpointDiffCluster returns the endpoint's cluster, or nil if the
+// cluster isn't known yet.
+func resourceClusterEndpointDiffCluster(diff *schema.ResourceDiff, meta interface{}) (*neptune.DBCluster, error) {
+	if !diff.NewValueKnown("cluster_identifier") {
+		return nil, nil
+	}
+
+	conn := meta.(*conns.AWSClient).NeptuneConn
+	clusterID := diff.Get("cluster_identifier").(string)
+
+	cluster, err := FindClusterByID(conn, clusterID)
+
+	if tfresource.NotFound(err) {
+		// The cluster is probably being created in the same apply.
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("describing Neptune Cluster (%s): %w", clusterID, err)
+	}
+
+	return cluster, nil
+}
+
+// resourceClusterEndpointCustomizeDiffMemberRoles checks, when opted in, that the
+// static members of a READER or WRITER endpoint have the matching instance role.
+// SDK v2 CustomizeDiff can't return warnings, so as an opt-in check this fails
+// the plan.
+func resourceClusterEndpointCustomizeDiffMemberRoles(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_member_roles").(bool) {
+		return nil
+	}
+
+	endpointType := diff.Get("endpoint_type").(string)
+	if endpointType != clusterEndpointTypeReader && endpointType != clusterEndpointTypeWriter {
+		return nil
+	}
+
+	if !diff.NewValueKnown("static_members") || !diff.NewValueKnown("static_members_ordered") {
+		return nil
+	}
+
+	staticMembers := expandClusterEndpointStaticMembers(diff)
+	if staticMembers.Len() == 0 {
+		return nil
+	}
+
+	cluster, err := resourceClusterEndpointDiffCluster(diff, meta)
+
+	if err != nil || cluster == nil {
+		return err
+	}
+
+	if mismatched := clusterEndpointMismatchedStaticMembers(endpointType, flex.ExpandStringValueSet(staticMembers), cluster); len(mismatched) > 0 {
+		return fmt.Errorf("static_members of a %s endpoint must have the matching instance role in Neptune Cluster (%s): %s", endpointType, aws.StringValue(cluster.DBClusterIdentifier), strings.Join(mismatched, ", "))
+	}
+
+	return nil
+}
+
+// resourceClusterEndpointCustomizeDiffEffectiveMembers checks, when opted in, that
+// excluded_members doesn't leave an endpoint without static members with no
+// instance to route to. The members are worked out the same way as by the
+// aws_neptune_cluster_endpoint_members data source. SDK v2 CustomizeDiff can't
+// return warnings, so as an opt-in check this fails the plan.
+func resourceClusterEndpointCustomizeDiffEffectiveMembers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_effective_members").(bool) {
+		return nil
+	}
+
+	if !diff.NewValueKnown("endpoint_type") || !diff.NewValueKnown("static_members") || !diff.NewValueKnown("static_members_ordered") || !diff.NewValueKnown("excluded_members") {
+		return nil
+	}
+
+	// Static members may be instances created in the same apply, so only the
+	// excluded_members case can be checked against the cluster's current members.
+	excludedMembers := diff.Get("excluded_members").(*schema.Set)
+	if expandClusterEndpointStaticMembers(diff).Len() > 0 || excludedMembers.Len() == 0 {
+		return nil
+	}
+
+	cluster, err := resourceClusterEndpointDiffCluster(diff, meta)
+
+	if err != nil || cluster == nil {
+		return err
+	}
+
+	endpoint := &neptune.DBClusterEndpoint{
+		CustomEndpointType: aws.String(diff.Get("endpoint_type").(string)),
+		ExcludedMembers:    flex.ExpandStringSet(excludedMembers),
+	}
+
+	if len(flattenClusterEndpointEffectiveMembers(endpoint, cluster)) > 0 {
+		return nil
+	}
+
+	return fmt.Errorf("excluded_members leaves the %s endpoint with no instances in Neptune Cluster (%s)", aws.StringValue(endpoint.CustomEndpointType), aws.StringValue(cluster.DBClusterIdentifier))
+}
+
+// resourceClusterEndpointCustomizeDiffPlanEffectiveMembers plans, when opted in,
+// the instances the endpoint will route to, so that the plan shows which
+// instances a member or type change adds and removes. SDK v2 CustomizeDiff
+// can't attach notes to a plan, so the routing change is planned as a change
+// to effective_members instead. It is left unknown when it can't be predicted
+// from the configuration and the cluster's current members.
+func resourceClusterEndpointCustomizeDiffPlanEffectiveMembers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("plan_effective_members").(bool) {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("endpoint_type", "excluded_members", "plan_effective_members", "static_members", "static_members_ordered") {
+		return nil
+	}
+
+	cluster, err := resourceClusterEndpointDiffCluster(diff, meta)
+
+	if err != nil {
+		return err
+	}
+
+	// Members that Terraform doesn't manage aren't known from the configuration.
+	if cluster == nil || !diff.Get("authoritative_members").(bool) || !diff.NewValueKnown("endpoint_type") || !diff.NewValueKnown("static_members") || !diff.NewValueKnown("static_members_ordered") || !diff.NewValueKnown("excluded_members") {
+		return diff.SetNewComputed("effective_members")
+	}
+
+	endpoint := &neptune.DBClusterEndpoint{
+		CustomEndpointType: aws.String(diff.Get("endpoint_type").(string)),
+		ExcludedMembers:    flex.ExpandStringSet(diff.Get("excluded_members").(*schema.Set)),
+		StaticMembers:      flex.ExpandStringSet(expandClusterEndpointStaticMembers(diff)),
+	}
+
+	return diff.SetNew("effective_members", flattenClusterEndpointEffectiveMembers(endpoint, cluster))
+}
+
+// resourceClusterEndpointCustomizeDiffOverlappingMembers rejects instances that
+// are both static and excluded members, which the API only reports once the
+// create or modify call is made.
+func resourceClusterEndpointCustomizeDiffOverlappingMembers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("static_members") || !diff.NewValueKnown("static_members_ordered") || !diff.NewValueKnown("excluded_members") {
+		return nil
+	}
+
+	staticMembers := flex.ExpandStringValueSet(expandClusterEndpointStaticMembers(diff))
+	excludedMembers := flex.ExpandStringValueSet(diff.Get("excluded_members").(*schema.Set))
+
+	if overlapping := clusterEndpointOverlappingMembers(staticMembers, excludedMembers); len(overlapping) > 0 {
+		return fmt.Errorf("instances can't be both static_members and excluded_members: %s", strings.Join(overlapping, ", "))
+	}
+
+	return nil
+}
+
+// resourceClusterEndpointCustomizeDiffEmptyStaticMembers rejects an explicitly
+// empty static_members or static_members_ordered list. The provider can't tell it
+// apart from an omitted argument, so it would silently get the omitted-argument
+// behavior.
+func resourceClusterEndpointCustomizeDiffEmptyStaticMembers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, name := range []string{"static_members", "static_members_ordered"} {
+		if clusterEndpointStaticMembersExplicitlyEmpty(diff.GetRawConfig(), name) {
+			return fmt.Errorf("%s must not be an empty list: omit the argument instead to include all eligible instances not in excluded_members", name)
+		}
 	}
+
+	return nil
 }
 
 func resourceClusterEndpointCreate(d *schema.ResourceData, meta interface{}) error {
@@ -77,13 +418,20 @@ func resourceClusterEndpointCreate(d *schema.ResourceData, meta interface{}) err
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	identifier := create.Name(d.Get("cluster_endpoint_identifier").(string), d.Get("cluster_endpoint_identifier_prefix").(string))
+
+	// A generated identifier is only known here, so validate the final value too.
+	if _, errs := validIdentifier(identifier, "cluster_endpoint_identifier"); len(errs) > 0 {
+		return fmt.Errorf("creating Neptune Cluster Endpoint (%s): %w", identifier, errs[0])
+	}
+
 	input := &neptune.CreateDBClusterEndpointInput{
-		DBClusterEndpointIdentifier: aws.String(d.Get("cluster_endpoint_identifier").(string)),
+		DBClusterEndpointIdentifier: aws.String(identifier),
 		DBClusterIdentifier:         aws.String(d.Get("cluster_identifier").(string)),
 		EndpointType:                aws.String(d.Get("endpoint_type").(string)),
 	}
 
-	if attr := d.Get("static_members").(*schema.Set); attr.Len() > 0 {
+	if attr := expandClusterEndpointStaticMembers(d); attr.Len() > 0 {
 		input.StaticMembers = flex.ExpandStringSet(attr)
 	}
 
@@ -91,21 +439,66 @@ func resourceClusterEndpointCreate(d *schema.ResourceData, meta interface{}) err
 		input.ExcludedMembers = flex.ExpandStringSet(attr)
 	}
 
-	// Tags are currently only supported in AWS Commercial.
-	if len(tags) > 0 && meta.(*conns.AWSClient).Partition == endpoints.AwsPartitionID {
-		input.Tags = Tags(tags.IgnoreAWS())
+	// Keys with the reserved "aws:" prefix are rejected by the API and can't be set.
+	if ignored := tags.Removed(tags.IgnoreAWS()); len(ignored) > 0 {
+		log.Printf("[WARN] Ignoring reserved tag keys for Neptune Cluster Endpoint (%s): %s", identifier, strings.Join(ignored.Keys(), ", "))
 	}
 
-	out, err := conn.CreateDBClusterEndpoint(input)
+	if tags := tags.IgnoreAWS(); len(tags) > 0 && clusterEndpointTagsSupported(meta.(*conns.AWSClient).Partition) {
+		input.Tags = Tags(tags)
+	}
+
+	if err := resourceClusterEndpointCheckGlobalSecondary(conn, d.Get("cluster_identifier").(string), d.Get("endpoint_type").(string)); err != nil {
+		return fmt.Errorf("creating Neptune Cluster Endpoint (%s): %w", identifier, err)
+	}
+
+	outputRaw, err := retryWhenClusterStateInvalid(d.Timeout(schema.TimeoutCreate), adoptWhenAlreadyExistsOnRetry(func() (interface{}, bool, error) {
+		return sendDBClusterEndpointCreateWithTags(conn, input)
+	}, func() (interface{}, error) {
+		// The endpoint may not be visible yet to a describe.
+		outputRaw, err := tfresource.RetryWhenNotFound(propagationTimeout, func() (interface{}, error) {
+			return FindEndpointByID(conn, fmt.Sprintf("%s:%s", aws.StringValue(input.DBClusterIdentifier), aws.StringValue(input.DBClusterEndpointIdentifier)))
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint := outputRaw.(*neptune.DBClusterEndpoint)
+
+		// Only an endpoint as requested can be the one created by the earlier attempt.
+		if !clusterEndpointMatchesCreateInput(endpoint, input) {
+			return nil, fmt.Errorf("endpoint (%s) already exists with a different type or members", aws.StringValue(endpoint.DBClusterEndpointIdentifier))
+		}
+
+		return &neptune.CreateDBClusterEndpointOutput{
+			DBClusterEndpointIdentifier: endpoint.DBClusterEndpointIdentifier,
+			DBClusterIdentifier:         endpoint.DBClusterIdentifier,
+		}, nil
+	}))
 	if err != nil {
 		return fmt.Errorf("creating Neptune Cluster Endpoint: %w", err)
 	}
 
+	out := outputRaw.(*neptune.CreateDBClusterEndpointOutput)
+
 	clusterId := aws.StringValue(out.DBClusterIdentifier)
 	endpointId := aws.StringValue(out.DBClusterEndpointIdentifier)
 	d.SetId(fmt.Sprintf("%s:%s", clusterId, endpointId))
+	d.Set("last_modified_time", time.Now().UTC().Format(time.RFC3339))
+
+	// The read that follows still retries until the new endpoint is visible.
+	if d.Get("skip_create_wait").(bool) {
+		return resourceClusterEndpointRead(d, meta)
+	}
+
+	// The ID stays set on failure so that the endpoint is tracked; Terraform marks it
+	// tainted, so untainting it lets the next apply reconcile instead of replace it.
+	_, err = WaitDBClusterEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if tfresource.TimedOut(err) {
+		return fmt.Errorf("Neptune Cluster Endpoint (%s) was created but is not yet available: %w\n[WARNING] The endpoint is tainted and will be replaced by the next apply; run `terraform untaint` on it to keep it", d.Id(), err)
+	}
 
-	_, err = WaitDBClusterEndpointAvailable(conn, d.Id())
 	if err != nil {
 		return fmt.Errorf("waiting for Neptune Cluster Endpoint (%q) to be Available: %w", d.Id(), err)
 	}
@@ -119,7 +512,15 @@ func resourceClusterEndpointRead(d *schema.ResourceData, meta interface{}) error
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	resp, err := FindEndpointByID(conn, d.Id())
+	// Immediately after create the endpoint may not yet be visible, so allow a
+	// short bounded retry. Ordinary refreshes fail fast on NotFound.
+	var cluster *neptune.DBCluster
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(propagationTimeout, func() (interface{}, error) {
+		endpoint, c, err := FindEndpointAndClusterByID(conn, d.Id())
+		cluster = c
+		return endpoint, err
+	}, d.IsNewResource())
+
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		d.SetId("")
 		log.Printf("[DEBUG] Neptune Cluster Endpoint (%s) not found", d.Id())
@@ -130,33 +531,69 @@ func resourceClusterEndpointRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("describing Neptune Cluster Endpoint (%s): %w", d.Id(), err)
 	}
 
+	resp := outputRaw.(*neptune.DBClusterEndpoint)
+
 	d.Set("cluster_endpoint_identifier", resp.DBClusterEndpointIdentifier)
+	d.Set("cluster_endpoint_identifier_prefix", create.NamePrefixFromName(aws.StringValue(resp.DBClusterEndpointIdentifier)))
 	d.Set("cluster_identifier", resp.DBClusterIdentifier)
 	d.Set("endpoint_type", resp.CustomEndpointType)
 	d.Set("endpoint", resp.Endpoint)
-	d.Set("excluded_members", flex.FlattenStringSet(resp.ExcludedMembers))
-	d.Set("static_members", flex.FlattenStringSet(resp.StaticMembers))
 
-	arn := aws.StringValue(resp.DBClusterEndpointArn)
+	staticMembers := aws.StringValueSlice(resp.StaticMembers)
+	excludedMembers := aws.StringValueSlice(resp.ExcludedMembers)
+
+	// Members added by other owners aren't drift when Terraform only manages its own.
+	if !clusterEndpointAuthoritativeMembers(d) {
+		staticMembers = clusterEndpointManagedMembers(flex.ExpandStringValueSet(expandClusterEndpointStaticMembers(d)), staticMembers)
+		excludedMembers = clusterEndpointManagedMembers(flex.ExpandStringValueSet(d.Get("excluded_members").(*schema.Set)), excludedMembers)
+	}
+
+	d.Set("excluded_members", excludedMembers)
+	// Keep whichever of the two static members attributes is in use.
+	if ordered := flex.ExpandStringValueList(d.Get("static_members_ordered").([]interface{})); len(ordered) > 0 {
+		d.Set("static_members", nil)
+		d.Set("static_members_ordered", flattenClusterEndpointOrderedStaticMembers(ordered, staticMembers))
+	} else {
+		d.Set("static_members", staticMembers)
+	}
+	d.Set("status", resp.Status)
+
+	arn, err := flattenClusterEndpointARN(aws.StringValue(resp.DBClusterEndpointArn))
+
+	if err != nil {
+		return err
+	}
+
 	d.Set("arn", arn)
 
-	// Tags are currently only supported in AWS Commercial.
-	if meta.(*conns.AWSClient).Partition == endpoints.AwsPartitionID {
-		tags, err := ListTags(conn, arn)
+	// The cluster describe can fail independently of the endpoint's, so fall back
+	// to building the cluster ARN from the endpoint's.
+	var clusterARN string
+	if cluster != nil {
+		clusterARN, err = flattenClusterARN(aws.StringValue(cluster.DBClusterArn))
+	} else {
+		clusterARN, err = clusterARNFromEndpointARN(arn, aws.StringValue(resp.DBClusterIdentifier))
+	}
+
+	if err != nil {
+		return err
+	}
 
-		if err != nil {
-			return fmt.Errorf("listing tags for Neptune Cluster Endpoint (%s): %w", arn, err)
-		}
+	d.Set("cluster_arn", clusterARN)
 
-		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	if cluster != nil {
+		resourceClusterEndpointReadCluster(d, conn, cluster)
 
-		//lintignore:AWSR002
-		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-			return fmt.Errorf("setting tags: %w", err)
+		if d.Get("plan_effective_members").(bool) {
+			d.Set("effective_members", flattenClusterEndpointEffectiveMembers(resp, cluster))
+		} else {
+			d.Set("effective_members", nil)
 		}
+	}
 
-		if err := d.Set("tags_all", tags.Map()); err != nil {
-			return fmt.Errorf("setting tags_all: %w", err)
+	if clusterEndpointTagsSupported(meta.(*conns.AWSClient).Partition) {
+		if err := resourceClusterEndpointReadTags(d, conn, arn, defaultTagsConfig, ignoreTagsConfig); err != nil {
+			return err
 		}
 	} else {
 		d.Set("tags", nil)
@@ -166,49 +603,281 @@ func resourceClusterEndpointRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// resourceClusterEndpointReadCluster sets the attributes that are derived from
+// the endpoint's parent cluster. The endpoint read itself has already succeeded,
+// so a failed subnet group lookup only leaves db_subnet_group_name and vpc_id as
+// they were.
+func resourceClusterEndpointReadCluster(d *schema.ResourceData, conn *neptune.Neptune, cluster *neptune.DBCluster) {
+	d.Set("cluster_reader_endpoint", cluster.ReaderEndpoint)
+	d.Set("cluster_resource_id", cluster.DbClusterResourceId)
+	d.Set("cluster_writer_endpoint", cluster.Endpoint)
+	// The query languages a client can use depend on the engine version.
+	d.Set("engine_version", cluster.EngineVersion)
+	d.Set("iam_database_authentication_enabled", cluster.IAMDatabaseAuthenticationEnabled)
+	// Custom endpoints listen on the cluster's port, which needn't be the 8182 default.
+	d.Set("port", cluster.Port)
+
+	if d.Get("detect_stale_static_members").(bool) {
+		resourceClusterEndpointReadStaleStaticMembers(d, cluster)
+	}
+
+	subnetGroupName := aws.StringValue(cluster.DBSubnetGroup)
+
+	// A subnet group can't move to another VPC, so only describe it when it changes.
+	if subnetGroupName == d.Get("db_subnet_group_name").(string) && d.Get("vpc_id").(string) != "" {
+		return
+	}
+
+	if subnetGroupName == "" {
+		d.Set("db_subnet_group_name", nil)
+		d.Set("vpc_id", nil)
+		return
+	}
+
+	subnetGroup, err := FindSubnetGroupByName(conn, subnetGroupName)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Neptune Subnet Group (%s) for Cluster Endpoint (%s) not found", subnetGroupName, d.Id())
+		d.Set("db_subnet_group_name", subnetGroupName)
+		d.Set("vpc_id", nil)
+		return
+	}
+
+	// Keep the previous pair so that the lookup is retried on the next refresh.
+	if err != nil {
+		log.Printf("[WARN] describing Neptune Subnet Group (%s) for Cluster Endpoint (%s): %s", subnetGroupName, d.Id(), err)
+		return
+	}
+
+	d.Set("db_subnet_group_name", subnetGroupName)
+	d.Set("vpc_id", subnetGroup.VpcId)
+}
+
+// resourceClusterEndpointReadStaleStaticMembers drops static members that are no
+// longer instances of the cluster from state, so that the next plan shows them
+// as drift instead of silently routing to fewer instances than configured.
+func resourceClusterEndpointReadStaleStaticMembers(d *schema.ResourceData, cluster *neptune.DBCluster) {
+	ordered := flex.ExpandStringValueList(d.Get("static_members_ordered").([]interface{}))
+	current, stale := clusterEndpointStaleStaticMembers(flex.ExpandStringValueSet(expandClusterEndpointStaticMembers(d)), cluster)
+
+	if len(stale) == 0 {
+		return
+	}
+
+	log.Printf("[WARN] Neptune Cluster Endpoint (%s) static members are no longer in Neptune Cluster (%s): %s", d.Id(), aws.StringValue(cluster.DBClusterIdentifier), strings.Join(stale, ", "))
+
+	if len(ordered) > 0 {
+		d.Set("static_members_ordered", flattenClusterEndpointOrderedStaticMembers(ordered, current))
+		return
+	}
+
+	d.Set("static_members", current)
+}
+
 func resourceClusterEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).NeptuneConn
+	modified := false
 
-	if d.HasChangesExcept("tags", "tags_all") {
-		req := &neptune.ModifyDBClusterEndpointInput{
-			DBClusterEndpointIdentifier: aws.String(d.Get("cluster_endpoint_identifier").(string)),
-		}
+	if req := expandClusterEndpointModifyInput(d); req != nil {
+		staticMembers, excludedMembers := expandClusterEndpointStaticMembers(d), d.Get("excluded_members").(*schema.Set)
 
-		if d.HasChange("endpoint_type") {
-			req.EndpointType = aws.String(d.Get("endpoint_type").(string))
-		}
+		if !d.Get("authoritative_members").(bool) && d.HasChanges("endpoint_type", "static_members", "static_members_ordered", "excluded_members") {
+			endpoint, err := FindEndpointByID(conn, d.Id())
+			if err != nil {
+				return fmt.Errorf("reading Neptune Cluster Endpoint (%q) members: %w", d.Id(), err)
+			}
 
-		if d.HasChange("static_members") {
-			req.StaticMembers = flex.ExpandStringSet(d.Get("static_members").(*schema.Set))
+			staticMembers, excludedMembers = expandClusterEndpointMergedMembers(d, endpoint)
+			req.StaticMembers = flex.ExpandStringSet(staticMembers)
+			req.ExcludedMembers = flex.ExpandStringSet(excludedMembers)
 		}
 
-		if d.HasChange("excluded_members") {
-			req.ExcludedMembers = flex.ExpandStringSet(d.Get("excluded_members").(*schema.Set))
+		if d.HasChange("endpoint_type") {
+			if err := resourceClusterEndpointCheckGlobalSecondary(conn, d.Get("cluster_identifier").(string), d.Get("endpoint_type").(string)); err != nil {
+				return fmt.Errorf("updating Neptune Cluster Endpoint (%q): %w", d.Id(), err)
+			}
 		}
 
-		_, err := conn.ModifyDBClusterEndpoint(req)
+		_, err := retryWhenClusterStateInvalid(d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.ModifyDBClusterEndpoint(req)
+		})
 		if err != nil {
 			return fmt.Errorf("updating Neptune Cluster Endpoint (%q): %w", d.Id(), err)
 		}
 
-		_, err = WaitDBClusterEndpointAvailable(conn, d.Id())
+		_, err = WaitDBClusterEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("waiting for Neptune Cluster Endpoint (%q) to be Available: %w", d.Id(), err)
 		}
+
+		if d.HasChanges("static_members", "static_members_ordered", "excluded_members") {
+			_, err = WaitDBClusterEndpointInSync(conn, d.Id(), staticMembers, excludedMembers, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return fmt.Errorf("waiting for Neptune Cluster Endpoint (%q) members to be in sync: %w", d.Id(), err)
+			}
+		}
+
+		modified = true
 	}
 
-	// Tags are currently only supported in AWS Commercial.
-	if d.HasChange("tags_all") && meta.(*conns.AWSClient).Partition == endpoints.AwsPartitionID {
+	if d.HasChange("tags_all") && clusterEndpointTagsSupported(meta.(*conns.AWSClient).Partition) {
 		o, n := d.GetChange("tags_all")
+		v, ok := d.GetOk("tags")
+		updated, err := updateClusterEndpointTags(conn, d.Get("arn").(string), o, n, !ok || len(v.(map[string]interface{})) == 0)
 
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("updating Neptune Cluster Endpoint (%s) tags: %w", d.Get("arn").(string), err)
+		if err != nil {
+			return err
 		}
+
+		modified = modified || updated
+	}
+
+	if modified {
+		d.Set("last_modified_time", time.Now().UTC().Format(time.RFC3339))
 	}
 
 	return resourceClusterEndpointRead(d, meta)
 }
 
+// clusterEndpointTagsSupported returns whether Neptune cluster endpoints are
+// tagged in the partition. Tagging is attempted everywhere except the China
+// partition, where it isn't supported.
+func clusterEndpointTagsSupported(partition string) bool {
+	return partition != endpoints.AwsCnPartitionID
+}
+
+// sendDBClusterEndpointCreateWithTags sends the create request and, where
+// tag-on-create isn't supported, sends it again without tags.
+func sendDBClusterEndpointCreateWithTags(conn *neptune.Neptune, input *neptune.CreateDBClusterEndpointInput) (*neptune.CreateDBClusterEndpointOutput, bool, error) {
+	output, retried, err := sendDBClusterEndpointCreate(conn, input)
+
+	if input.Tags != nil && verify.ErrorISOUnsupported(conn.PartitionID, err) {
+		log.Printf("[WARN] failed creating Neptune Cluster Endpoint (%s) with tags: %s. Trying create without tags.", aws.StringValue(input.DBClusterEndpointIdentifier), err)
+		input.Tags = nil
+
+		var retriedWithoutTags bool
+		output, retriedWithoutTags, err = sendDBClusterEndpointCreate(conn, input)
+		retried = retried || retriedWithoutTags
+	}
+
+	return output, retried, err
+}
+
+// resourceClusterEndpointReadTags sets tags and tags_all from the endpoint's
+// tags. Where listing tags isn't supported, they keep their values.
+func resourceClusterEndpointReadTags(d *schema.ResourceData, conn *neptune.Neptune, arn string, defaultTagsConfig *tftags.DefaultConfig, ignoreTagsConfig *tftags.IgnoreConfig) error {
+	tags, err := ListTags(conn, arn)
+
+	if verify.ErrorISOUnsupported(conn.PartitionID, err) {
+		log.Printf("[WARN] failed listing tags for Neptune Cluster Endpoint (%s): %s", arn, err)
+	} else if err != nil {
+		return fmt.Errorf("listing tags for Neptune Cluster Endpoint (%s): %w", arn, err)
+	} else {
+		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+		//lintignore:AWSR002
+		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+			return fmt.Errorf("setting tags: %w", err)
+		}
+
+		if err := d.Set("tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("setting tags_all: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// updateClusterEndpointTags updates the endpoint's tags from o to n and returns
+// whether they were updated. Where tagging isn't supported, a change of only the
+// provider default tags is logged and skipped, while configured tags fail.
+func updateClusterEndpointTags(conn *neptune.Neptune, arn string, o, n interface{}, onlyDefaultTags bool) (bool, error) {
+	err := UpdateTags(conn, arn, o, n)
+
+	switch {
+	case onlyDefaultTags && verify.ErrorISOUnsupported(conn.PartitionID, err):
+		log.Printf("[WARN] failed updating tags for Neptune Cluster Endpoint (%s): %s", arn, err)
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("updating Neptune Cluster Endpoint (%s) tags: %w", arn, err)
+	default:
+		return true, nil
+	}
+}
+
+// resourceClusterEndpointCheckGlobalSecondary rejects a WRITER endpoint on a
+// cluster that is a read-only secondary of a global cluster, which has no writer
+// instance. If the cluster or global clusters can't be described, the API is left
+// to report any problem.
+func resourceClusterEndpointCheckGlobalSecondary(conn *neptune.Neptune, clusterID, endpointType string) error {
+	if endpointType != clusterEndpointTypeWriter {
+		return nil
+	}
+
+	cluster, err := FindClusterByID(conn, clusterID)
+
+	if err != nil {
+		log.Printf("[WARN] describing Neptune Cluster (%s): %s", clusterID, err)
+		return nil
+	}
+
+	globalClusters, err := FindGlobalClusters(conn)
+
+	if err != nil {
+		log.Printf("[WARN] describing Neptune Global Clusters: %s", err)
+		return nil
+	}
+
+	if id := clusterGlobalSecondaryOf(globalClusters, aws.StringValue(cluster.DBClusterArn)); id != "" {
+		return fmt.Errorf("a %s endpoint can't be used on Neptune Cluster (%s), a read-only secondary of Global Cluster (%s)", endpointType, clusterID, id)
+	}
+
+	return nil
+}
+
+// resourceClusterEndpointImport rejects a malformed ID, or one naming an endpoint
+// that doesn't exist in the cluster, before it is written to state.
+func resourceClusterEndpointImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	if arn.IsARN(d.Id()) {
+		endpoint, err := FindEndpointByARN(conn, d.Id())
+
+		if tfresource.NotFound(err) {
+			return nil, fmt.Errorf("importing Neptune Cluster Endpoint (%s): endpoint not found", d.Id())
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("importing Neptune Cluster Endpoint (%s): %w", d.Id(), err)
+		}
+
+		d.SetId(aws.StringValue(endpoint.DBClusterIdentifier) + ":" + aws.StringValue(endpoint.DBClusterEndpointIdentifier))
+		d.Set("authoritative_members", true)
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	clusterID, endpointID, err := validClusterEndpointImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = FindEndpointByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil, fmt.Errorf("importing Neptune Cluster Endpoint (%s): endpoint %s not found in Neptune Cluster (%s)", d.Id(), endpointID, clusterID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("importing Neptune Cluster Endpoint (%s): %w", d.Id(), err)
+	}
+
+	d.Set("authoritative_members", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceClusterEndpointDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).NeptuneConn
 
@@ -217,7 +886,9 @@ func resourceClusterEndpointDelete(d *schema.ResourceData, meta interface{}) err
 		DBClusterEndpointIdentifier: aws.String(endpointId),
 	}
 
-	_, err := conn.DeleteDBClusterEndpoint(input)
+	_, err := retryWhenClusterStateInvalid(d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteDBClusterEndpoint(input)
+	})
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterEndpointNotFoundFault) ||
 			tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterNotFoundFault) {
@@ -225,7 +896,12 @@ func resourceClusterEndpointDelete(d *schema.ResourceData, meta interface{}) err
 		}
 		return fmt.Errorf("Neptune Cluster Endpoint cannot be deleted: %w", err)
 	}
-	_, err = WaitDBClusterEndpointDeleted(conn, d.Id())
+
+	if d.Get("skip_delete_wait").(bool) {
+		return nil
+	}
+
+	_, err = WaitDBClusterEndpointDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterEndpointNotFoundFault) {
 			return nil
@@ -0,0 +1,64 @@
+package neptune
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceClusterEndpointMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClusterEndpointMembersRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_endpoint_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"cluster_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"endpoint_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterEndpointMembersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	clusterID := d.Get("cluster_identifier").(string)
+	endpointID := d.Get("cluster_endpoint_identifier").(string)
+	id := fmt.Sprintf("%s:%s", clusterID, endpointID)
+
+	endpoint, err := FindEndpointByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading Neptune Cluster Endpoint (%s): %w", id, err)
+	}
+
+	cluster, err := FindClusterByID(conn, clusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading Neptune Cluster (%s): %w", clusterID, err)
+	}
+
+	d.SetId(id)
+	d.Set("endpoint_type", endpoint.CustomEndpointType)
+
+	if err := d.Set("members", flattenClusterEndpointEffectiveMembers(endpoint, cluster)); err != nil {
+		return fmt.Errorf("setting members: %w", err)
+	}
+
+	return nil
+}
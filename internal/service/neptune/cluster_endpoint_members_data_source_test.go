@@ -0,0 +1,50 @@
+package neptune_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/neptune"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNeptuneClusterEndpointMembersDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.aws_neptune_cluster_endpoint_members.test"
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointMembersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_type", resourceName, "endpoint_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "members.0", "aws_neptune_cluster_instance.test.1", "identifier"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterEndpointMembersDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "ANY"
+  excluded_members            = [aws_neptune_cluster_instance.test[0].id]
+}
+
+data "aws_neptune_cluster_endpoint_members" "test" {
+  cluster_identifier          = aws_neptune_cluster_endpoint.test.cluster_identifier
+  cluster_endpoint_identifier = aws_neptune_cluster_endpoint.test.cluster_endpoint_identifier
+}
+`, rName))
+}
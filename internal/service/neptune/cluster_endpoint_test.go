@@ -6,6 +6,8 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -36,25 +38,560 @@ func TestAccNeptuneClusterEndpoint_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "READER"),
 					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_identifier", rName),
 					resource.TestCheckResourceAttrPair(resourceName, "cluster_identifier", "aws_neptune_cluster.test", "cluster_identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_arn", "aws_neptune_cluster.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "static_members.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "excluded_members.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_reader_endpoint", "aws_neptune_cluster.test", "reader_endpoint"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_resource_id", "aws_neptune_cluster.test", "cluster_resource_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_writer_endpoint", "aws_neptune_cluster.test", "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "db_subnet_group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", "aws_neptune_cluster.test", "engine_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_database_authentication_enabled", "aws_neptune_cluster.test", "iam_database_authentication_enabled"),
+					resource.TestCheckResourceAttr(resourceName, "port", "8182"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_id"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detect_stale_static_members", "last_modified_time", "plan_effective_members", "skip_create_wait", "skip_delete_wait", "validate_effective_members", "validate_member_roles"},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccClusterEndpointImportStateARNFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detect_stale_static_members", "last_modified_time", "plan_effective_members", "skip_create_wait", "skip_delete_wait", "validate_effective_members", "validate_member_roles"},
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: rName,
+				ExpectError:   regexp.MustCompile(`expected ID in format clusterIdentifier:endpointIndetifer`),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%[1]s:%[1]s-missing", rName),
+				ExpectError:   regexp.MustCompile(`not found in Neptune Cluster`),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_tags(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detect_stale_static_members", "last_modified_time", "plan_effective_members", "skip_create_wait", "skip_delete_wait", "validate_effective_members", "validate_member_roles"},
+			},
+			{
+				Config: testAccClusterEndpointConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccClusterEndpointConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_tagsComputed(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccClusterEndpointConfig_tags1(rName, "key1", "value1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccClusterEndpointConfig_tagsComputed(rName, "key1", "value1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.computed", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags_all.computed", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccClusterEndpointConfig_tagsComputed(rName, "key1", "value1"),
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_disappears(t *testing.T) {
+	var dbCluster neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &dbCluster),
+					acctest.CheckResourceDisappears(acctest.Provider, tfneptune.ResourceClusterEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_Disappears_cluster(t *testing.T) {
+	var dbCluster neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &dbCluster),
+					acctest.CheckResourceDisappears(acctest.Provider, tfneptune.ResourceCluster(), "aws_neptune_cluster.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_staticMembers(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_staticMembers(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "ANY"),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "static_members.*", "aws_neptune_cluster_instance.test.0", "identifier"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detect_stale_static_members", "last_modified_time", "plan_effective_members", "skip_create_wait", "skip_delete_wait", "validate_effective_members", "validate_member_roles"},
+			},
+			{
+				Config: testAccClusterEndpointConfig_staticMembersUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "static_members.*", "aws_neptune_cluster_instance.test.0", "identifier"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "static_members.*", "aws_neptune_cluster_instance.test.1", "identifier"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_StaticMembers_outOfBand(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_staticMembers(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					testAccCheckClusterEndpointSetStaticMembers(&v, rName+"-0", rName+"-1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccClusterEndpointConfig_staticMembers(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "static_members.*", "aws_neptune_cluster_instance.test.0", "identifier"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_StaticMembers_nonAuthoritative(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_staticMembersNonAuthoritative(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authoritative_members", "false"),
+					testAccCheckClusterEndpointSetStaticMembers(&v, rName+"-0", rName+"-1"),
+				),
+			},
+			{
+				Config: testAccClusterEndpointConfig_staticMembersNonAuthoritative(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					testAccCheckClusterEndpointStaticMembersCount(&v, 2),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "static_members.*", "aws_neptune_cluster_instance.test.0", "identifier"),
+				),
+			},
+			{
+				// Dropping the managed member keeps the one added out of band.
+				Config: testAccClusterEndpointConfig_staticMembersNonAuthoritative(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					testAccCheckClusterEndpointStaticMembersCount(&v, 1),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "static_members.*", "aws_neptune_cluster_instance.test.1", "identifier"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_planEffectiveMembers(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_planEffectiveMembers(rName, "[aws_neptune_cluster_instance.test[0].identifier]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "effective_members.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "effective_members.0", "aws_neptune_cluster_instance.test.0", "identifier"),
+				),
+			},
+			{
+				Config: testAccClusterEndpointConfig_planEffectiveMembers(rName, "aws_neptune_cluster_instance.test[*].identifier"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "effective_members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_staticMembersOrdered(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_staticMembersOrdered(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "static_members_ordered.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "static_members_ordered.0", "aws_neptune_cluster_instance.test.1", "identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "static_members_ordered.1", "aws_neptune_cluster_instance.test.0", "identifier"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_identifierGenerated(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_identifierGenerated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					acctest.CheckResourceAttrNameGenerated(resourceName, "cluster_endpoint_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_identifier_prefix", "terraform-"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detect_stale_static_members", "last_modified_time", "plan_effective_members", "skip_create_wait", "skip_delete_wait", "validate_effective_members", "validate_member_roles"},
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_identifierPrefix(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_identifierPrefix(rName, "tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					acctest.CheckResourceAttrNameFromPrefix(resourceName, "cluster_endpoint_identifier", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_identifier_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detect_stale_static_members", "last_modified_time", "plan_effective_members", "skip_create_wait", "skip_delete_wait", "validate_effective_members", "validate_member_roles"},
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_fipsEndpoint(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckRegion(t, endpoints.UsWest2RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_fipsEndpoint(fmt.Sprintf("https://rds-fips.%s.%s", acctest.Region(), acctest.PartitionDNSSuffix()), rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+				),
+			},
+			{
+				Config: testAccClusterEndpointConfig_fipsEndpointTags1(fmt.Sprintf("https://rds-fips.%s.%s", acctest.Region(), acctest.PartitionDNSSuffix()), rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_endpointType(t *testing.T) {
+	var v1, v2 neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "READER"),
+				),
+			},
+			{
+				Config: testAccClusterEndpointConfig_endpointType(rName, "ANY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v2),
+					testAccCheckClusterEndpointNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "ANY"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_EndpointType_staticMembers(t *testing.T) {
+	var v1, v2 neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_endpointTypeStaticMembers(rName, "ANY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v1),
+					testAccCheckClusterEndpointStaticMembersCount(&v1, 1),
+				),
+			},
+			{
+				Config: testAccClusterEndpointConfig_endpointTypeStaticMembers(rName, "READER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v2),
+					testAccCheckClusterEndpointNotRecreated(&v1, &v2),
+					testAccCheckClusterEndpointStaticMembersCount(&v2, 1),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "READER"),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "static_members.*", "aws_neptune_cluster_instance.test.0", "identifier"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_timeouts(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterEndpointConfig_timeouts(rName, "1s"),
+				ExpectError: regexp.MustCompile(`timeout while waiting for state to become 'available'`),
+			},
+			{
+				Config: testAccClusterEndpointConfig_timeouts(rName, "20m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_skipCreateWait(t *testing.T) {
+	var v neptune.DBClusterEndpoint
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_skipCreateWait(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "skip_create_wait", "true"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccNeptuneClusterEndpoint_tags(t *testing.T) {
-	if acctest.Partition() == "aws-us-gov" {
-		t.Skip("Neptune Cluster Endpoint tags are not supported in GovCloud partition")
-	}
-
+func TestAccNeptuneClusterEndpoint_port(t *testing.T) {
 	var v neptune.DBClusterEndpoint
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster_endpoint.test"
@@ -66,43 +603,59 @@ func TestAccNeptuneClusterEndpoint_tags(t *testing.T) {
 		CheckDestroy:             testAccCheckClusterEndpointDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClusterEndpointConfig_tags1(rName, "key1", "value1"),
+				Config: testAccClusterEndpointConfig_port(rName, 8183),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterEndpointExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "port", "8183"),
+					resource.TestCheckResourceAttrPair(resourceName, "port", "aws_neptune_cluster.test", "port"),
 				),
 			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_validateEffectiveMembers(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: testAccClusterEndpointConfig_base(rName),
 			},
 			{
-				Config: testAccClusterEndpointConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckClusterEndpointExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
-				),
+				Config:      testAccClusterEndpointConfig_validateEffectiveMembers(rName),
+				ExpectError: regexp.MustCompile(`excluded_members leaves the ANY endpoint with no instances`),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpoint_validateMemberRoles(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_base(rName),
 			},
 			{
-				Config: testAccClusterEndpointConfig_tags1(rName, "key2", "value2"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckClusterEndpointExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
-				),
+				Config:      testAccClusterEndpointConfig_validateMemberRoles(rName),
+				ExpectError: regexp.MustCompile(`static_members of a WRITER endpoint must have the matching instance role`),
 			},
 		},
 	})
 }
 
-func TestAccNeptuneClusterEndpoint_disappears(t *testing.T) {
-	var dbCluster neptune.DBClusterEndpoint
+func TestAccNeptuneClusterEndpoint_emptyStaticMembers(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
-	resourceName := "aws_neptune_cluster_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -111,21 +664,15 @@ func TestAccNeptuneClusterEndpoint_disappears(t *testing.T) {
 		CheckDestroy:             testAccCheckClusterEndpointDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClusterEndpointConfig_basic(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckClusterEndpointExists(resourceName, &dbCluster),
-					acctest.CheckResourceDisappears(acctest.Provider, tfneptune.ResourceClusterEndpoint(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
+				Config:      testAccClusterEndpointConfig_emptyStaticMembers(rName),
+				ExpectError: regexp.MustCompile(`static_members must not be an empty list`),
 			},
 		},
 	})
 }
 
-func TestAccNeptuneClusterEndpoint_Disappears_cluster(t *testing.T) {
-	var dbCluster neptune.DBClusterEndpoint
+func TestAccNeptuneClusterEndpoint_overlappingMembers(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
-	resourceName := "aws_neptune_cluster_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -134,17 +681,71 @@ func TestAccNeptuneClusterEndpoint_Disappears_cluster(t *testing.T) {
 		CheckDestroy:             testAccCheckClusterEndpointDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClusterEndpointConfig_basic(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckClusterEndpointExists(resourceName, &dbCluster),
-					acctest.CheckResourceDisappears(acctest.Provider, tfneptune.ResourceCluster(), "aws_neptune_cluster.test"),
-				),
-				ExpectNonEmptyPlan: true,
+				Config:      testAccClusterEndpointConfig_overlappingMembers(rName),
+				ExpectError: regexp.MustCompile(`instances can't be both static_members and excluded_members: ` + rName + `-instance`),
 			},
 		},
 	})
 }
 
+func testAccClusterEndpointImportStateARNFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
+
+func testAccCheckClusterEndpointNotRecreated(before, after *neptune.DBClusterEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.DBClusterEndpointResourceIdentifier), aws.StringValue(after.DBClusterEndpointResourceIdentifier); before != after {
+			return fmt.Errorf("Neptune Cluster Endpoint was recreated: %s != %s", before, after)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckClusterEndpointSetStaticMembers modifies the endpoint's static
+// members outside of Terraform, as an operator using the console would.
+func testAccCheckClusterEndpointSetStaticMembers(v *neptune.DBClusterEndpoint, staticMembers ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+		id := aws.StringValue(v.DBClusterEndpointIdentifier)
+
+		_, err := conn.ModifyDBClusterEndpoint(&neptune.ModifyDBClusterEndpointInput{
+			DBClusterEndpointIdentifier: aws.String(id),
+			StaticMembers:               aws.StringSlice(staticMembers),
+		})
+
+		if err != nil {
+			return fmt.Errorf("modifying Neptune Cluster Endpoint (%s) static members: %w", id, err)
+		}
+
+		members := make([]interface{}, len(staticMembers))
+		for i, member := range staticMembers {
+			members[i] = member
+		}
+
+		_, err = tfneptune.WaitDBClusterEndpointInSync(conn, aws.StringValue(v.DBClusterIdentifier)+":"+id, schema.NewSet(schema.HashString, members), schema.NewSet(schema.HashString, nil), tfneptune.DBClusterEndpointAvailableTimeout)
+
+		return err
+	}
+}
+
+func testAccCheckClusterEndpointStaticMembersCount(v *neptune.DBClusterEndpoint, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(v.StaticMembers); got != expected {
+			return fmt.Errorf("Neptune Cluster Endpoint (%s) has %d static members, expected %d", aws.StringValue(v.DBClusterEndpointIdentifier), got, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckClusterEndpointDestroy(s *terraform.State) error {
 	return testAccCheckClusterEndpointDestroyWithProvider(s, acctest.Provider)
 }
@@ -199,24 +800,41 @@ func testAccCheckClusterEndpointExistsWithProvider(n string, v *neptune.DBCluste
 	}
 }
 
-func testAccClusterEndpointBaseConfig(rName string) string {
+func testAccClusterEndpointConfig_base(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 locals {
   availability_zone_names = slice(data.aws_availability_zones.available.names, 0, min(3, length(data.aws_availability_zones.available.names)))
 }
 
+data "aws_neptune_orderable_db_instance" "test" {
+  engine        = "neptune"
+  license_model = "amazon-license"
+
+  preferred_instance_classes = ["db.t3.medium", "db.r5.large", "db.r4.large"]
+}
+
 resource "aws_neptune_cluster" "test" {
   cluster_identifier                   = %[1]q
   availability_zones                   = local.availability_zone_names
   engine                               = "neptune"
+  engine_version                       = data.aws_neptune_orderable_db_instance.test.engine_version
   neptune_cluster_parameter_group_name = "default.neptune1"
   skip_final_snapshot                  = true
 }
+
+resource "aws_neptune_cluster_instance" "test" {
+  count = 2
+
+  identifier         = "%[1]s-${count.index}"
+  cluster_identifier = aws_neptune_cluster.test.id
+  instance_class     = data.aws_neptune_orderable_db_instance.test.instance_class
+  engine_version     = data.aws_neptune_orderable_db_instance.test.engine_version
+}
 `, rName))
 }
 
 func testAccClusterEndpointConfig_basic(rName string) string {
-	return acctest.ConfigCompose(testAccClusterEndpointBaseConfig(rName), fmt.Sprintf(`
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
 resource "aws_neptune_cluster_endpoint" "test" {
   cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
   cluster_endpoint_identifier = %[1]q
@@ -226,7 +844,7 @@ resource "aws_neptune_cluster_endpoint" "test" {
 }
 
 func testAccClusterEndpointConfig_tags1(rName, tagKey1, tagValue1 string) string {
-	return acctest.ConfigCompose(testAccClusterEndpointBaseConfig(rName), fmt.Sprintf(`
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
 resource "aws_neptune_cluster_endpoint" "test" {
   cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
   cluster_endpoint_identifier = %[1]q
@@ -240,7 +858,7 @@ resource "aws_neptune_cluster_endpoint" "test" {
 }
 
 func testAccClusterEndpointConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
-	return acctest.ConfigCompose(testAccClusterEndpointBaseConfig(rName), fmt.Sprintf(`
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
 resource "aws_neptune_cluster_endpoint" "test" {
   cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
   cluster_endpoint_identifier = %[1]q
@@ -253,3 +871,257 @@ resource "aws_neptune_cluster_endpoint" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccClusterEndpointConfig_staticMembers(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "ANY"
+  static_members              = [aws_neptune_cluster_instance.test[0].identifier]
+}
+`, rName))
+}
+
+func testAccClusterEndpointConfig_staticMembersNonAuthoritative(rName, index string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "ANY"
+  static_members              = [aws_neptune_cluster_instance.test[%[2]s].identifier]
+  authoritative_members       = false
+}
+`, rName, index))
+}
+
+func testAccClusterEndpointConfig_planEffectiveMembers(rName, staticMembers string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "ANY"
+  static_members              = %[2]s
+  plan_effective_members      = true
+}
+`, rName, staticMembers))
+}
+
+func testAccClusterEndpointConfig_staticMembersUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "ANY"
+  static_members              = aws_neptune_cluster_instance.test[*].identifier
+}
+`, rName))
+}
+
+func testAccClusterEndpointConfig_staticMembersOrdered(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "ANY"
+  static_members_ordered      = reverse(aws_neptune_cluster_instance.test[*].identifier)
+}
+`, rName))
+}
+
+func testAccClusterEndpointConfig_identifierGenerated(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), `
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier = aws_neptune_cluster.test.cluster_identifier
+  endpoint_type      = "READER"
+}
+`)
+}
+
+func testAccClusterEndpointConfig_identifierPrefix(rName, identifierPrefix string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier                 = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier_prefix = %[1]q
+  endpoint_type                      = "READER"
+}
+`, identifierPrefix))
+}
+
+func testAccClusterEndpointConfig_fipsEndpoint(endpoint, rName string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(fmt.Sprintf(`
+provider "aws" {
+  endpoints {
+    neptune = %[1]q
+  }
+}
+`, endpoint), testAccClusterEndpointConfig_basic(rName))
+}
+
+func testAccClusterEndpointConfig_fipsEndpointTags1(endpoint, rName, tagKey1, tagValue1 string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(fmt.Sprintf(`
+provider "aws" {
+  endpoints {
+    neptune = %[1]q
+  }
+}
+`, endpoint), testAccClusterEndpointConfig_tags1(rName, tagKey1, tagValue1))
+}
+
+func testAccClusterEndpointConfig_endpointType(rName, endpointType string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = %[2]q
+}
+`, rName, endpointType))
+}
+
+func testAccClusterEndpointConfig_endpointTypeStaticMembers(rName, endpointType string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = %[2]q
+  static_members              = [aws_neptune_cluster_instance.test[0].identifier]
+}
+`, rName, endpointType))
+}
+
+func testAccClusterEndpointConfig_timeouts(rName, timeout string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "READER"
+
+  timeouts {
+    create = %[2]q
+    update = %[2]q
+    delete = %[2]q
+  }
+}
+`, rName, timeout))
+}
+
+func testAccClusterEndpointConfig_overlappingMembers(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = %[1]q
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "ANY"
+  static_members              = ["%[1]s-instance"]
+  excluded_members            = ["%[1]s-instance"]
+}
+`, rName)
+}
+
+func testAccClusterEndpointConfig_tagsComputed(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "READER"
+
+  tags = {
+    %[2]q    = %[3]q
+    computed = aws_sns_topic.test.arn
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccClusterEndpointConfig_validateEffectiveMembers(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "ANY"
+  excluded_members            = aws_neptune_cluster_instance.test[*].id
+
+  validate_effective_members = true
+}
+`, rName))
+}
+
+func testAccClusterEndpointConfig_validateMemberRoles(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "WRITER"
+  static_members              = aws_neptune_cluster_instance.test[*].id
+
+  validate_member_roles = true
+}
+`, rName))
+}
+
+func testAccClusterEndpointConfig_emptyStaticMembers(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = %[1]q
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "ANY"
+  static_members              = []
+}
+`, rName)
+}
+
+func testAccClusterEndpointConfig_port(rName string, port int) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+locals {
+  availability_zone_names = slice(data.aws_availability_zones.available.names, 0, min(3, length(data.aws_availability_zones.available.names)))
+}
+
+data "aws_neptune_orderable_db_instance" "test" {
+  engine        = "neptune"
+  license_model = "amazon-license"
+
+  preferred_instance_classes = ["db.t3.medium", "db.r5.large", "db.r4.large"]
+}
+
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier                   = %[1]q
+  availability_zones                   = local.availability_zone_names
+  engine                               = "neptune"
+  engine_version                       = data.aws_neptune_orderable_db_instance.test.engine_version
+  neptune_cluster_parameter_group_name = "default.neptune1"
+  port                                 = %[2]d
+  skip_final_snapshot                  = true
+}
+
+resource "aws_neptune_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_neptune_cluster.test.id
+  instance_class     = data.aws_neptune_orderable_db_instance.test.instance_class
+  engine_version     = data.aws_neptune_orderable_db_instance.test.engine_version
+}
+
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "READER"
+
+  depends_on = [aws_neptune_cluster_instance.test]
+}
+`, rName, port))
+}
+
+func testAccClusterEndpointConfig_skipCreateWait(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "READER"
+  skip_create_wait            = true
+}
+`, rName))
+}
@@ -0,0 +1,148 @@
+package neptune
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceClusterEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClusterEndpointsRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(clusterEndpointType_Values(), false),
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_endpoint_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"excluded_members": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"static_members": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"endpoints_by_type": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	clusterID := d.Get("cluster_identifier").(string)
+
+	var endpoints []*neptune.DBClusterEndpoint
+	var err error
+	if v, ok := d.GetOk("endpoint_type"); ok {
+		endpoints, err = FindEndpointsByClusterIDAndType(conn, clusterID, v.(string))
+	} else {
+		endpoints, err = FindEndpointsByClusterID(conn, clusterID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Neptune Cluster (%s) Endpoints: %w", clusterID, err)
+	}
+
+	// Sort by identifier so that both the list and the by-type lookup are deterministic.
+	sort.Slice(endpoints, func(i, j int) bool {
+		return aws.StringValue(endpoints[i].DBClusterEndpointIdentifier) < aws.StringValue(endpoints[j].DBClusterEndpointIdentifier)
+	})
+
+	d.SetId(clusterID)
+
+	if err := d.Set("endpoints", flattenClusterEndpoints(endpoints)); err != nil {
+		return fmt.Errorf("setting endpoints: %w", err)
+	}
+
+	byType := make(map[string]string)
+	for _, endpoint := range endpoints {
+		endpointType := aws.StringValue(endpoint.CustomEndpointType)
+
+		if _, ok := byType[endpointType]; !ok {
+			byType[endpointType] = aws.StringValue(endpoint.Endpoint)
+		}
+	}
+
+	if err := d.Set("endpoints_by_type", byType); err != nil {
+		return fmt.Errorf("setting endpoints_by_type: %w", err)
+	}
+
+	cluster, err := FindClusterByID(conn, clusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading Neptune Cluster (%s): %w", clusterID, err)
+	}
+
+	d.Set("engine_version", cluster.EngineVersion)
+
+	return nil
+}
+
+func flattenClusterEndpoints(apiObjects []*neptune.DBClusterEndpoint) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                         aws.StringValue(apiObject.DBClusterEndpointArn),
+			"cluster_endpoint_identifier": aws.StringValue(apiObject.DBClusterEndpointIdentifier),
+			"endpoint":                    aws.StringValue(apiObject.Endpoint),
+			"endpoint_type":               aws.StringValue(apiObject.CustomEndpointType),
+			"excluded_members":            flex.FlattenStringSet(apiObject.ExcludedMembers),
+			"static_members":              flex.FlattenStringSet(apiObject.StaticMembers),
+			"status":                      aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,118 @@
+package neptune_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/neptune"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNeptuneClusterEndpointsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.aws_neptune_cluster_endpoints.test"
+	readerResourceName := "aws_neptune_cluster_endpoint.reader"
+	writerResourceName := "aws_neptune_cluster_endpoint.writer"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "endpoints.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoints_by_type.%", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoints_by_type.READER", readerResourceName, "endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoints_by_type.WRITER", writerResourceName, "endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "engine_version", "aws_neptune_cluster.test", "engine_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneClusterEndpointsDataSource_endpointType(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.aws_neptune_cluster_endpoints.test"
+	readerResourceName := "aws_neptune_cluster_endpoint.reader"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointsDataSourceConfig_endpointType(rName, "READER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "endpoints.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoints.0.endpoint", readerResourceName, "endpoint"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoints_by_type.%", "1"),
+				),
+			},
+			{
+				Config: testAccClusterEndpointsDataSourceConfig_endpointType(rName, "ANY"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "endpoints.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoints_by_type.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterEndpointsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "reader" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = "%[1]s-reader"
+  endpoint_type               = "READER"
+}
+
+resource "aws_neptune_cluster_endpoint" "writer" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = "%[1]s-writer"
+  endpoint_type               = "WRITER"
+}
+
+data "aws_neptune_cluster_endpoints" "test" {
+  cluster_identifier = aws_neptune_cluster.test.cluster_identifier
+
+  depends_on = [
+    aws_neptune_cluster_endpoint.reader,
+    aws_neptune_cluster_endpoint.writer,
+  ]
+}
+`, rName))
+}
+
+func testAccClusterEndpointsDataSourceConfig_endpointType(rName, endpointType string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "reader" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = "%[1]s-reader"
+  endpoint_type               = "READER"
+}
+
+resource "aws_neptune_cluster_endpoint" "writer" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = "%[1]s-writer"
+  endpoint_type               = "WRITER"
+}
+
+data "aws_neptune_cluster_endpoints" "test" {
+  cluster_identifier = aws_neptune_cluster.test.cluster_identifier
+  endpoint_type      = %[2]q
+
+  depends_on = [
+    aws_neptune_cluster_endpoint.reader,
+    aws_neptune_cluster_endpoint.writer,
+  ]
+}
+`, rName, endpointType))
+}
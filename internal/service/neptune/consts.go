@@ -3,5 +3,27 @@ package neptune
 import "time"
 
 const (
-	propagationTimeout = 2 * time.Minute
+	describeThrottlingTimeout = 30 * time.Second
+	propagationTimeout        = 2 * time.Minute
 )
+
+const (
+	errCodeRequestLimitExceeded = "RequestLimitExceeded"
+	errCodeThrottling           = "Throttling"
+	errCodeThrottlingException  = "ThrottlingException"
+)
+
+const (
+	clusterEndpointTypeAny    = "ANY"
+	clusterEndpointTypeCustom = "CUSTOM"
+	clusterEndpointTypeReader = "READER"
+	clusterEndpointTypeWriter = "WRITER"
+)
+
+func clusterEndpointType_Values() []string {
+	return []string{
+		clusterEndpointTypeAny,
+		clusterEndpointTypeReader,
+		clusterEndpointTypeWriter,
+	}
+}
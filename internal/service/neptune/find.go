@@ -1,10 +1,13 @@
 package neptune
 
 import (
+	"log"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindEndpointByID(conn *neptune.Neptune, id string) (*neptune.DBClusterEndpoint, error) {
@@ -17,7 +20,11 @@ func FindEndpointByID(conn *neptune.Neptune, id string) (*neptune.DBClusterEndpo
 		DBClusterEndpointIdentifier: aws.String(endpointId),
 	}
 
-	output, err := conn.DescribeDBClusterEndpoints(input)
+	outputRaw, err := retryWhenDescribeThrottled(func() (interface{}, error) {
+		return conn.DescribeDBClusterEndpoints(input)
+	})
+
+	output, _ := outputRaw.(*neptune.DescribeDBClusterEndpointsOutput)
 
 	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterEndpointNotFoundFault) ||
 		tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterNotFoundFault) {
@@ -48,3 +55,279 @@ func FindEndpointByID(conn *neptune.Neptune, id string) (*neptune.DBClusterEndpo
 
 	return endpoints[0], nil
 }
+
+// FindEndpointByARN returns the cluster endpoint with the specified ARN.
+// Endpoint identifiers are unique within an account and region, so the
+// endpoint is described without its cluster identifier.
+func FindEndpointByARN(conn *neptune.Neptune, arn string) (*neptune.DBClusterEndpoint, error) {
+	endpointID, err := validClusterEndpointImportARN(arn)
+	if err != nil {
+		return nil, err
+	}
+	input := &neptune.DescribeDBClusterEndpointsInput{
+		DBClusterEndpointIdentifier: aws.String(endpointID),
+	}
+
+	outputRaw, err := retryWhenDescribeThrottled(func() (interface{}, error) {
+		return conn.DescribeDBClusterEndpoints(input)
+	})
+
+	output, _ := outputRaw.(*neptune.DescribeDBClusterEndpointsOutput)
+
+	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterEndpointNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, endpoint := range output.DBClusterEndpoints {
+		// The ARN's partition, region and account must match too.
+		if endpoint != nil && aws.StringValue(endpoint.DBClusterEndpointArn) == arn {
+			return endpoint, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// FindEndpointAndClusterByID returns the cluster endpoint with the specified ID
+// and its parent cluster, so that all of the endpoint's cluster-derived attributes
+// share one cluster describe. Only the endpoint lookup can fail: if the cluster
+// can't be described, the reason is logged and a nil cluster is returned.
+func FindEndpointAndClusterByID(conn *neptune.Neptune, id string) (*neptune.DBClusterEndpoint, *neptune.DBCluster, error) {
+	endpoint, err := FindEndpointByID(conn, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clusterID := aws.StringValue(endpoint.DBClusterIdentifier)
+
+	outputRaw, err := retryWhenDescribeThrottled(func() (interface{}, error) {
+		return FindClusterByID(conn, clusterID)
+	})
+
+	if err != nil {
+		log.Printf("[WARN] describing Neptune Cluster (%s) for Cluster Endpoint (%s): %s", clusterID, id, err)
+		return endpoint, nil, nil
+	}
+
+	return endpoint, outputRaw.(*neptune.DBCluster), nil
+}
+
+// retryWhenDescribeThrottled retries a describe for a short time when Neptune
+// reports throttling. Neptune uses the RDS query API, which returns "Throttling".
+// The SDK's default retryer already retries throttling errors up to the
+// provider's max_retries; this only adds a bounded retry once it has given up,
+// as waiters poll describes repeatedly and would otherwise fail outright.
+func retryWhenDescribeThrottled(f func() (interface{}, error)) (interface{}, error) {
+	return tfresource.RetryWhenAWSErrCodeEquals(describeThrottlingTimeout, f, errCodeThrottling, errCodeThrottlingException, errCodeRequestLimitExceeded)
+}
+
+func FindClusterByID(conn *neptune.Neptune, id string) (*neptune.DBCluster, error) {
+	input := &neptune.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeDBClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	for _, cluster := range output.DBClusters {
+		if aws.StringValue(cluster.DBClusterIdentifier) == id {
+			return cluster, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Empty result",
+		LastRequest: input,
+	}
+}
+
+func FindSubnetGroupByName(conn *neptune.Neptune, name string) (*neptune.DBSubnetGroup, error) {
+	input := &neptune.DescribeDBSubnetGroupsInput{
+		DBSubnetGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDBSubnetGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBSubnetGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBSubnetGroups) == 0 || output.DBSubnetGroups[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.DBSubnetGroups[0], nil
+}
+
+// FindEndpointsByClusterID returns all custom endpoints of the specified cluster.
+// A NotFound error is returned only if the cluster itself does not exist.
+func FindEndpointsByClusterID(conn *neptune.Neptune, clusterID string) ([]*neptune.DBClusterEndpoint, error) {
+	input := &neptune.DescribeDBClusterEndpointsInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	}
+	endpoints := []*neptune.DBClusterEndpoint{}
+
+	err := conn.DescribeDBClusterEndpointsPages(input, func(page *neptune.DescribeDBClusterEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, endpoint := range page.DBClusterEndpoints {
+			// The cluster's built-in reader and writer endpoints are also returned.
+			if endpoint == nil || aws.StringValue(endpoint.EndpointType) != clusterEndpointTypeCustom {
+				continue
+			}
+
+			endpoints = append(endpoints, endpoint)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints, nil
+}
+
+// FindEndpointsByClusterIDAndType returns the custom endpoints of the specified
+// cluster that have the specified endpoint type, e.g. READER. A NotFound error is
+// returned only if the cluster itself does not exist.
+func FindEndpointsByClusterIDAndType(conn *neptune.Neptune, clusterID, endpointType string) ([]*neptune.DBClusterEndpoint, error) {
+	endpoints, err := FindEndpointsByClusterID(conn, clusterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterEndpointsOfType(endpoints, endpointType), nil
+}
+
+// clusterEndpointsOfType returns the custom endpoints with the specified type,
+// never nil.
+func clusterEndpointsOfType(endpoints []*neptune.DBClusterEndpoint, endpointType string) []*neptune.DBClusterEndpoint {
+	result := []*neptune.DBClusterEndpoint{}
+
+	for _, endpoint := range endpoints {
+		if aws.StringValue(endpoint.CustomEndpointType) == endpointType {
+			result = append(result, endpoint)
+		}
+	}
+
+	return result
+}
+
+func FindGlobalClusters(conn *neptune.Neptune) ([]*neptune.GlobalCluster, error) {
+	input := &neptune.DescribeGlobalClustersInput{}
+	var globalClusters []*neptune.GlobalCluster
+
+	for {
+		output, err := conn.DescribeGlobalClusters(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, globalCluster := range output.GlobalClusters {
+			if globalCluster != nil {
+				globalClusters = append(globalClusters, globalCluster)
+			}
+		}
+
+		if aws.StringValue(output.Marker) == "" {
+			break
+		}
+
+		input.Marker = output.Marker
+	}
+
+	return globalClusters, nil
+}
+
+func FindGlobalClusterByID(conn *neptune.Neptune, id string) (*neptune.GlobalCluster, error) {
+	input := &neptune.DescribeGlobalClustersInput{
+		GlobalClusterIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeGlobalClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeGlobalClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	for _, globalCluster := range output.GlobalClusters {
+		if aws.StringValue(globalCluster.GlobalClusterIdentifier) == id {
+			return globalCluster, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Empty result",
+		LastRequest: input,
+	}
+}
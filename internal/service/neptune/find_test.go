@@ -0,0 +1,89 @@
+package neptune
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/neptune"
+)
+
+func TestRetryWhenDescribeThrottled(t *testing.T) {
+	testCases := map[string]struct {
+		err         error
+		expectCalls int
+		expectError bool
+	}{
+		"Throttling": {
+			err:         awserr.New(errCodeThrottling, "Rate exceeded", nil),
+			expectCalls: 2,
+		},
+		"ThrottlingException": {
+			err:         awserr.New(errCodeThrottlingException, "Rate exceeded", nil),
+			expectCalls: 2,
+		},
+		"RequestLimitExceeded": {
+			err:         awserr.New(errCodeRequestLimitExceeded, "Request limit exceeded", nil),
+			expectCalls: 2,
+		},
+		"not retryable": {
+			err:         awserr.New(neptune.ErrCodeDBClusterEndpointNotFoundFault, "not found", nil),
+			expectCalls: 1,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			var calls int
+
+			output, err := retryWhenDescribeThrottled(func() (interface{}, error) {
+				calls++
+
+				if calls == 1 {
+					return nil, testCase.err
+				}
+
+				return "ok", nil
+			})
+
+			if calls != testCase.expectCalls {
+				t.Errorf("got %d calls, want %d", calls, testCase.expectCalls)
+			}
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if output != "ok" {
+				t.Errorf("got output %v, want ok", output)
+			}
+		})
+	}
+}
+
+func TestClusterEndpointsOfType(t *testing.T) {
+	endpoints := []*neptune.DBClusterEndpoint{
+		{DBClusterEndpointIdentifier: aws.String("reader1"), CustomEndpointType: aws.String("READER")},
+		{DBClusterEndpointIdentifier: aws.String("any"), CustomEndpointType: aws.String("ANY")},
+		{DBClusterEndpointIdentifier: aws.String("reader2"), CustomEndpointType: aws.String("READER")},
+	}
+
+	got := clusterEndpointsOfType(endpoints, "READER")
+
+	if len(got) != 2 || aws.StringValue(got[0].DBClusterEndpointIdentifier) != "reader1" || aws.StringValue(got[1].DBClusterEndpointIdentifier) != "reader2" {
+		t.Errorf("got %v, want the reader1 and reader2 endpoints", got)
+	}
+
+	if got := clusterEndpointsOfType(endpoints, "WRITER"); got == nil || len(got) != 0 {
+		t.Errorf("got %v, want an empty slice", got)
+	}
+}
@@ -1,8 +1,15 @@
 package neptune
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
 // Takes the result of flatmap.Expand for an array of parameters and
@@ -41,3 +48,378 @@ func flattenParameters(list []*neptune.Parameter) []map[string]interface{} {
 	}
 	return result
 }
+
+type clusterEndpointDiffer interface {
+	Get(key string) interface{}
+	HasChange(key string) bool
+}
+
+// expandClusterEndpointModifyInput returns the ModifyDBClusterEndpoint request for
+// the arguments that have changed, or nil if none of them have (e.g. a tag-only
+// update), in which case neither the modify call nor its waiter should run.
+// A change of endpoint type always sends the member lists too, so that they
+// can't be reset by a modify that omits them.
+func expandClusterEndpointModifyInput(d clusterEndpointDiffer) *neptune.ModifyDBClusterEndpointInput {
+	input := &neptune.ModifyDBClusterEndpointInput{
+		DBClusterEndpointIdentifier: aws.String(d.Get("cluster_endpoint_identifier").(string)),
+	}
+	modify := false
+
+	if d.HasChange("endpoint_type") {
+		input.EndpointType = aws.String(d.Get("endpoint_type").(string))
+		modify = true
+	}
+
+	if d.HasChange("endpoint_type") || d.HasChange("static_members") || d.HasChange("static_members_ordered") {
+		input.StaticMembers = flex.ExpandStringSet(expandClusterEndpointStaticMembers(d))
+		modify = true
+	}
+
+	if d.HasChange("endpoint_type") || d.HasChange("excluded_members") {
+		input.ExcludedMembers = flex.ExpandStringSet(d.Get("excluded_members").(*schema.Set))
+		modify = true
+	}
+
+	if !modify {
+		return nil
+	}
+
+	return input
+}
+
+// expandClusterEndpointMergedMembers returns the static and excluded members for
+// a non-authoritative update. Only the members that Terraform adds or removes
+// change, and those added by other owners of the endpoint are kept.
+func expandClusterEndpointMergedMembers(d *schema.ResourceData, endpoint *neptune.DBClusterEndpoint) (*schema.Set, *schema.Set) {
+	var oldStatic, newStatic []string
+	for _, name := range []string{"static_members", "static_members_ordered"} {
+		o, n := d.GetChange(name)
+
+		if v, ok := o.(*schema.Set); ok {
+			oldStatic = append(oldStatic, flex.ExpandStringValueSet(v)...)
+			newStatic = append(newStatic, flex.ExpandStringValueSet(n.(*schema.Set))...)
+		} else {
+			oldStatic = append(oldStatic, flex.ExpandStringValueList(o.([]interface{}))...)
+			newStatic = append(newStatic, flex.ExpandStringValueList(n.([]interface{}))...)
+		}
+	}
+
+	o, n := d.GetChange("excluded_members")
+
+	staticMembers := mergeClusterEndpointMembers(aws.StringValueSlice(endpoint.StaticMembers), oldStatic, newStatic)
+	excludedMembers := mergeClusterEndpointMembers(aws.StringValueSlice(endpoint.ExcludedMembers), flex.ExpandStringValueSet(o.(*schema.Set)), flex.ExpandStringValueSet(n.(*schema.Set)))
+
+	return flex.FlattenStringSet(aws.StringSlice(staticMembers)), flex.FlattenStringSet(aws.StringSlice(excludedMembers))
+}
+
+// clusterEndpointMatchesCreateInput reports whether the endpoint has the type and
+// members requested by the create input.
+func clusterEndpointMatchesCreateInput(endpoint *neptune.DBClusterEndpoint, input *neptune.CreateDBClusterEndpointInput) bool {
+	if !strings.EqualFold(aws.StringValue(endpoint.CustomEndpointType), aws.StringValue(input.EndpointType)) {
+		return false
+	}
+
+	return flex.FlattenStringSet(endpoint.StaticMembers).Equal(flex.FlattenStringSet(input.StaticMembers)) &&
+		flex.FlattenStringSet(endpoint.ExcludedMembers).Equal(flex.FlattenStringSet(input.ExcludedMembers))
+}
+
+// mergeClusterEndpointMembers returns the sorted current members without those
+// that Terraform previously managed but no longer does, plus those it now does.
+func mergeClusterEndpointMembers(current, o, n []string) []string {
+	members := make(map[string]bool)
+	for _, id := range current {
+		members[id] = true
+	}
+
+	for _, id := range o {
+		delete(members, id)
+	}
+
+	for _, id := range n {
+		members[id] = true
+	}
+
+	result := []string{}
+	for id := range members {
+		result = append(result, id)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// clusterEndpointManagedMembers returns the actual members that Terraform
+// manages, in their actual order.
+func clusterEndpointManagedMembers(managed, actual []string) []string {
+	members := make(map[string]bool)
+	for _, id := range managed {
+		members[id] = true
+	}
+
+	result := []string{}
+	for _, id := range actual {
+		if members[id] {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// expandClusterEndpointStaticMembers returns the static members configured with
+// either static_members or static_members_ordered, which conflict.
+func expandClusterEndpointStaticMembers(d interface{ Get(string) interface{} }) *schema.Set {
+	if v, ok := d.Get("static_members_ordered").([]interface{}); ok && len(v) > 0 {
+		return schema.NewSet(schema.HashString, v)
+	}
+
+	return d.Get("static_members").(*schema.Set)
+}
+
+// flattenClusterEndpointOrderedStaticMembers returns the endpoint's static
+// members in their previous order, so that static_members_ordered only shows a
+// diff when membership changes. Members that are new are appended, sorted.
+func flattenClusterEndpointOrderedStaticMembers(previous []string, staticMembers []string) []string {
+	members := make(map[string]bool)
+	for _, id := range staticMembers {
+		members[id] = true
+	}
+
+	var ordered, added []string
+	for _, id := range previous {
+		if members[id] {
+			ordered = append(ordered, id)
+			delete(members, id)
+		}
+	}
+
+	for id := range members {
+		added = append(added, id)
+	}
+
+	sort.Strings(added)
+
+	return append(ordered, added...)
+}
+
+// flattenClusterEndpointEffectiveMembers returns the sorted identifiers of the
+// cluster's instances that the custom endpoint currently routes to: its static
+// members if it has any, otherwise every member that isn't excluded, in either
+// case limited to the instance role that the endpoint type serves.
+func flattenClusterEndpointEffectiveMembers(endpoint *neptune.DBClusterEndpoint, cluster *neptune.DBCluster) []string {
+	staticMembers := make(map[string]bool)
+	for _, v := range endpoint.StaticMembers {
+		staticMembers[aws.StringValue(v)] = true
+	}
+
+	excludedMembers := make(map[string]bool)
+	for _, v := range endpoint.ExcludedMembers {
+		excludedMembers[aws.StringValue(v)] = true
+	}
+
+	endpointType := aws.StringValue(endpoint.CustomEndpointType)
+	members := []string{}
+
+	for _, member := range cluster.DBClusterMembers {
+		id := aws.StringValue(member.DBInstanceIdentifier)
+
+		if len(staticMembers) > 0 {
+			if !staticMembers[id] {
+				continue
+			}
+		} else if excludedMembers[id] {
+			continue
+		}
+
+		writer := aws.BoolValue(member.IsClusterWriter)
+
+		if (endpointType == clusterEndpointTypeReader && writer) || (endpointType == clusterEndpointTypeWriter && !writer) {
+			continue
+		}
+
+		members = append(members, id)
+	}
+
+	sort.Strings(members)
+
+	return members
+}
+
+// clusterEndpointOverlappingMembers returns the sorted instances that are both
+// static and excluded members.
+func clusterEndpointOverlappingMembers(staticMembers, excludedMembers []string) []string {
+	excluded := make(map[string]bool)
+	for _, id := range excludedMembers {
+		excluded[id] = true
+	}
+
+	var overlapping []string
+	for _, id := range staticMembers {
+		if excluded[id] {
+			overlapping = append(overlapping, id)
+		}
+	}
+
+	sort.Strings(overlapping)
+
+	return overlapping
+}
+
+// clusterEndpointMismatchedStaticMembers returns the sorted static members of a
+// READER or WRITER endpoint whose instance role in the cluster doesn't match the
+// endpoint type. Static members that aren't instances of the cluster are ignored.
+func clusterEndpointMismatchedStaticMembers(endpointType string, staticMembers []string, cluster *neptune.DBCluster) []string {
+	if endpointType != clusterEndpointTypeReader && endpointType != clusterEndpointTypeWriter {
+		return nil
+	}
+
+	isWriter := make(map[string]bool)
+	for _, member := range cluster.DBClusterMembers {
+		isWriter[aws.StringValue(member.DBInstanceIdentifier)] = aws.BoolValue(member.IsClusterWriter)
+	}
+
+	var mismatched []string
+	for _, id := range staticMembers {
+		writer, ok := isWriter[id]
+
+		if !ok {
+			continue
+		}
+
+		if writer != (endpointType == clusterEndpointTypeWriter) {
+			mismatched = append(mismatched, id)
+		}
+	}
+
+	sort.Strings(mismatched)
+
+	return mismatched
+}
+
+// clusterEndpointStaleStaticMembers splits static members into those that are
+// still instances of the cluster and those that aren't, each sorted.
+func clusterEndpointStaleStaticMembers(staticMembers []string, cluster *neptune.DBCluster) ([]string, []string) {
+	members := make(map[string]bool)
+	for _, member := range cluster.DBClusterMembers {
+		members[aws.StringValue(member.DBInstanceIdentifier)] = true
+	}
+
+	var current, stale []string
+	for _, id := range staticMembers {
+		if members[id] {
+			current = append(current, id)
+		} else {
+			stale = append(stale, id)
+		}
+	}
+
+	sort.Strings(current)
+	sort.Strings(stale)
+
+	return current, stale
+}
+
+// flattenClusterEndpointARN returns the canonical form of a cluster endpoint ARN,
+// so that cosmetic differences in the API's formatting don't show up as diffs. An
+// error is returned if it isn't a well-formed Neptune cluster endpoint ARN.
+func flattenClusterEndpointARN(v string) (string, error) {
+	parsed, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing Neptune Cluster Endpoint ARN (%s): %w", v, err)
+	}
+
+	// Neptune shares the RDS ARN namespace.
+	if parsed.Service != "rds" || !strings.HasPrefix(parsed.Resource, "cluster-endpoint:") {
+		return "", fmt.Errorf("%s is not a Neptune Cluster Endpoint ARN", v)
+	}
+
+	return parsed.String(), nil
+}
+
+// flattenClusterARN validates a Neptune cluster ARN, such as the cluster_arn of
+// a cluster endpoint.
+func flattenClusterARN(v string) (string, error) {
+	parsed, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing Neptune Cluster ARN (%s): %w", v, err)
+	}
+
+	if parsed.Service != "rds" || !strings.HasPrefix(parsed.Resource, "cluster:") {
+		return "", fmt.Errorf("%s is not a Neptune Cluster ARN", v)
+	}
+
+	return parsed.String(), nil
+}
+
+// clusterARNFromEndpointARN builds the ARN of an endpoint's cluster from the
+// partition, region and account of the endpoint's own ARN.
+func clusterARNFromEndpointARN(endpointARN, clusterID string) (string, error) {
+	parsed, err := arn.Parse(endpointARN)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing Neptune Cluster Endpoint ARN (%s): %w", endpointARN, err)
+	}
+
+	parsed.Resource = "cluster:" + clusterID
+
+	return flattenClusterARN(parsed.String())
+}
+
+// clusterGlobalSecondaryOf returns the identifier of the global cluster in which
+// the cluster is a read-only secondary, or "" if it is the primary or not a
+// member of any global cluster.
+func clusterGlobalSecondaryOf(globalClusters []*neptune.GlobalCluster, clusterARN string) string {
+	for _, globalCluster := range globalClusters {
+		for _, member := range globalCluster.GlobalClusterMembers {
+			if member == nil || aws.StringValue(member.DBClusterArn) != clusterARN {
+				continue
+			}
+
+			if aws.BoolValue(member.IsWriter) {
+				return ""
+			}
+
+			return aws.StringValue(globalCluster.GlobalClusterIdentifier)
+		}
+	}
+
+	return ""
+}
+
+func flattenGlobalClusterMembers(apiObjects []*neptune.GlobalClusterMember) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"db_cluster_arn": aws.StringValue(apiObject.DBClusterArn),
+			"is_writer":      aws.BoolValue(apiObject.IsWriter),
+		})
+	}
+
+	return tfList
+}
+
+// globalClusterMajorVersionUpgrade reports whether changing a global cluster's
+// engine version between the two versions is a major version upgrade. Neptune
+// engine versions are of the form 1.2.0.0, where the first two parts are the
+// major version.
+func globalClusterMajorVersionUpgrade(oldVersion, newVersion string) bool {
+	oldParts, newParts := strings.Split(oldVersion, "."), strings.Split(newVersion, ".")
+
+	if len(oldParts) < 2 || len(newParts) < 2 {
+		return false
+	}
+
+	return oldParts[0] != newParts[0] || oldParts[1] != newParts[1]
+}
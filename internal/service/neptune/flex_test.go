@@ -0,0 +1,621 @@
+package neptune
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type mockClusterEndpointDiffer struct {
+	values  map[string]interface{}
+	changed map[string]bool
+}
+
+func (d *mockClusterEndpointDiffer) Get(key string) interface{} {
+	return d.values[key]
+}
+
+func (d *mockClusterEndpointDiffer) HasChange(key string) bool {
+	return d.changed[key]
+}
+
+func TestExpandClusterEndpointModifyInput(t *testing.T) {
+	values := map[string]interface{}{
+		"cluster_endpoint_identifier": "test",
+		"endpoint_type":               "ANY",
+		"excluded_members":            schema.NewSet(schema.HashString, nil),
+		"static_members":              schema.NewSet(schema.HashString, []interface{}{"instance-1"}),
+		"static_members_ordered":      []interface{}{},
+		"tags":                        map[string]interface{}{"key1": "value1"},
+		"tags_all":                    map[string]interface{}{"key1": "value1"},
+	}
+
+	testCases := map[string]struct {
+		changed       map[string]bool
+		expectModify  bool
+		expectMembers bool
+	}{
+		"no changes": {
+			changed: map[string]bool{},
+		},
+		"tags only": {
+			changed: map[string]bool{"tags": true, "tags_all": true},
+		},
+		"tags_all only": {
+			changed: map[string]bool{"tags_all": true},
+		},
+		"endpoint_type": {
+			changed:       map[string]bool{"endpoint_type": true},
+			expectModify:  true,
+			expectMembers: true,
+		},
+		"static_members and tags": {
+			changed:      map[string]bool{"static_members": true, "tags": true, "tags_all": true},
+			expectModify: true,
+		},
+		"excluded_members": {
+			changed:      map[string]bool{"excluded_members": true},
+			expectModify: true,
+		},
+		"static_members_ordered": {
+			changed:      map[string]bool{"static_members_ordered": true},
+			expectModify: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			input := expandClusterEndpointModifyInput(&mockClusterEndpointDiffer{values: values, changed: testCase.changed})
+
+			if !testCase.expectModify {
+				if input != nil {
+					t.Fatalf("expected no ModifyDBClusterEndpoint call, got: %s", input)
+				}
+				return
+			}
+
+			if input == nil {
+				t.Fatal("expected ModifyDBClusterEndpoint call, got none")
+			}
+
+			if got, want := aws.StringValue(input.DBClusterEndpointIdentifier), "test"; got != want {
+				t.Errorf("DBClusterEndpointIdentifier = %q, want %q", got, want)
+			}
+
+			if testCase.expectMembers {
+				if got, want := aws.StringValueSlice(input.StaticMembers), []string{"instance-1"}; !reflect.DeepEqual(got, want) {
+					t.Errorf("StaticMembers = %v, want %v", got, want)
+				}
+
+				if input.ExcludedMembers == nil {
+					t.Error("ExcludedMembers = nil, want the current (empty) list")
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenClusterEndpointOrderedStaticMembers(t *testing.T) {
+	testCases := map[string]struct {
+		previous      []string
+		staticMembers []string
+		expected      []string
+	}{
+		"none": {},
+		"unchanged order kept": {
+			previous:      []string{"instance-3", "instance-1", "instance-2"},
+			staticMembers: []string{"instance-1", "instance-2", "instance-3"},
+			expected:      []string{"instance-3", "instance-1", "instance-2"},
+		},
+		"removed out of band": {
+			previous:      []string{"instance-3", "instance-1", "instance-2"},
+			staticMembers: []string{"instance-2", "instance-3"},
+			expected:      []string{"instance-3", "instance-2"},
+		},
+		"added out of band": {
+			previous:      []string{"instance-3", "instance-1"},
+			staticMembers: []string{"instance-5", "instance-1", "instance-4", "instance-3"},
+			expected:      []string{"instance-3", "instance-1", "instance-4", "instance-5"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			if got := flattenClusterEndpointOrderedStaticMembers(testCase.previous, testCase.staticMembers); !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenClusterEndpointEffectiveMembers(t *testing.T) {
+	cluster := &neptune.DBCluster{
+		DBClusterMembers: []*neptune.DBClusterMember{
+			{DBInstanceIdentifier: aws.String("writer"), IsClusterWriter: aws.Bool(true)},
+			{DBInstanceIdentifier: aws.String("reader-2"), IsClusterWriter: aws.Bool(false)},
+			{DBInstanceIdentifier: aws.String("reader-1"), IsClusterWriter: aws.Bool(false)},
+		},
+	}
+
+	testCases := map[string]struct {
+		endpoint *neptune.DBClusterEndpoint
+		expected []string
+	}{
+		"any": {
+			endpoint: &neptune.DBClusterEndpoint{CustomEndpointType: aws.String("ANY")},
+			expected: []string{"reader-1", "reader-2", "writer"},
+		},
+		"reader": {
+			endpoint: &neptune.DBClusterEndpoint{CustomEndpointType: aws.String("READER")},
+			expected: []string{"reader-1", "reader-2"},
+		},
+		"writer": {
+			endpoint: &neptune.DBClusterEndpoint{CustomEndpointType: aws.String("WRITER")},
+			expected: []string{"writer"},
+		},
+		"excluded": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String("READER"),
+				ExcludedMembers:    aws.StringSlice([]string{"reader-1"}),
+			},
+			expected: []string{"reader-2"},
+		},
+		"static": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String("ANY"),
+				StaticMembers:      aws.StringSlice([]string{"writer", "reader-1"}),
+			},
+			expected: []string{"reader-1", "writer"},
+		},
+		"static no longer in cluster": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String("ANY"),
+				StaticMembers:      aws.StringSlice([]string{"deleted"}),
+			},
+			expected: []string{},
+		},
+		"all excluded": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String("READER"),
+				ExcludedMembers:    aws.StringSlice([]string{"reader-1", "reader-2"}),
+			},
+			expected: []string{},
+		},
+		"writer excluded": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String("WRITER"),
+				ExcludedMembers:    aws.StringSlice([]string{"writer"}),
+			},
+			expected: []string{},
+		},
+		"readers excluded from any": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String("ANY"),
+				ExcludedMembers:    aws.StringSlice([]string{"reader-1", "reader-2"}),
+			},
+			expected: []string{"writer"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			got := flattenClusterEndpointEffectiveMembers(testCase.endpoint, cluster)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterEndpointMismatchedStaticMembers(t *testing.T) {
+	cluster := &neptune.DBCluster{
+		DBClusterMembers: []*neptune.DBClusterMember{
+			{DBInstanceIdentifier: aws.String("writer"), IsClusterWriter: aws.Bool(true)},
+			{DBInstanceIdentifier: aws.String("reader-2"), IsClusterWriter: aws.Bool(false)},
+			{DBInstanceIdentifier: aws.String("reader-1"), IsClusterWriter: aws.Bool(false)},
+		},
+	}
+
+	testCases := map[string]struct {
+		endpointType  string
+		staticMembers []string
+		expected      []string
+	}{
+		"reader with readers": {
+			endpointType:  "READER",
+			staticMembers: []string{"reader-1", "reader-2"},
+		},
+		"reader with writer": {
+			endpointType:  "READER",
+			staticMembers: []string{"writer", "reader-1"},
+			expected:      []string{"writer"},
+		},
+		"writer with writer": {
+			endpointType:  "WRITER",
+			staticMembers: []string{"writer"},
+		},
+		"writer with readers": {
+			endpointType:  "WRITER",
+			staticMembers: []string{"reader-2", "writer", "reader-1"},
+			expected:      []string{"reader-1", "reader-2"},
+		},
+		"any": {
+			endpointType:  "ANY",
+			staticMembers: []string{"writer", "reader-1"},
+		},
+		"not in cluster": {
+			endpointType:  "WRITER",
+			staticMembers: []string{"deleted"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			got := clusterEndpointMismatchedStaticMembers(testCase.endpointType, testCase.staticMembers, cluster)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterEndpointOverlappingMembers(t *testing.T) {
+	testCases := map[string]struct {
+		staticMembers   []string
+		excludedMembers []string
+		expected        []string
+	}{
+		"none": {},
+		"static only": {
+			staticMembers: []string{"instance-1"},
+		},
+		"excluded only": {
+			excludedMembers: []string{"instance-1"},
+		},
+		"disjoint": {
+			staticMembers:   []string{"instance-1"},
+			excludedMembers: []string{"instance-2"},
+		},
+		"overlapping": {
+			staticMembers:   []string{"instance-3", "instance-1", "instance-2"},
+			excludedMembers: []string{"instance-4", "instance-3", "instance-1"},
+			expected:        []string{"instance-1", "instance-3"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			got := clusterEndpointOverlappingMembers(testCase.staticMembers, testCase.excludedMembers)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterEndpointStaleStaticMembers(t *testing.T) {
+	cluster := &neptune.DBCluster{
+		DBClusterMembers: []*neptune.DBClusterMember{
+			{DBInstanceIdentifier: aws.String("instance-1")},
+			{DBInstanceIdentifier: aws.String("instance-2")},
+		},
+	}
+
+	testCases := map[string]struct {
+		staticMembers   []string
+		expectedCurrent []string
+		expectedStale   []string
+	}{
+		"none": {},
+		"all current": {
+			staticMembers:   []string{"instance-2", "instance-1"},
+			expectedCurrent: []string{"instance-1", "instance-2"},
+		},
+		"deleted out of band": {
+			staticMembers:   []string{"instance-1", "deleted-2", "deleted-1"},
+			expectedCurrent: []string{"instance-1"},
+			expectedStale:   []string{"deleted-1", "deleted-2"},
+		},
+		"all deleted": {
+			staticMembers: []string{"deleted"},
+			expectedStale: []string{"deleted"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			current, stale := clusterEndpointStaleStaticMembers(testCase.staticMembers, cluster)
+
+			if !reflect.DeepEqual(current, testCase.expectedCurrent) {
+				t.Errorf("got current %v, want %v", current, testCase.expectedCurrent)
+			}
+
+			if !reflect.DeepEqual(stale, testCase.expectedStale) {
+				t.Errorf("got stale %v, want %v", stale, testCase.expectedStale)
+			}
+		})
+	}
+}
+
+func TestClusterGlobalSecondaryOf(t *testing.T) {
+	primaryARN := "arn:aws:rds:us-east-1:123456789012:cluster:primary"
+	secondaryARN := "arn:aws:rds:us-west-2:123456789012:cluster:secondary"
+
+	globalClusters := []*neptune.GlobalCluster{
+		{
+			GlobalClusterIdentifier: aws.String("other"),
+		},
+		{
+			GlobalClusterIdentifier: aws.String("global"),
+			GlobalClusterMembers: []*neptune.GlobalClusterMember{
+				{DBClusterArn: aws.String(primaryARN), IsWriter: aws.Bool(true)},
+				{DBClusterArn: aws.String(secondaryARN), IsWriter: aws.Bool(false)},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		clusterARN string
+		expected   string
+	}{
+		"primary": {
+			clusterARN: primaryARN,
+		},
+		"secondary": {
+			clusterARN: secondaryARN,
+			expected:   "global",
+		},
+		"not a member": {
+			clusterARN: "arn:aws:rds:us-east-1:123456789012:cluster:standalone",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			if got := clusterGlobalSecondaryOf(globalClusters, testCase.clusterARN); got != testCase.expected {
+				t.Errorf("got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenClusterARN(t *testing.T) {
+	testCases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"valid": {
+			value: "arn:aws:rds:us-west-2:123456789012:cluster:test",
+		},
+		"malformed": {
+			value:       "cluster:test",
+			expectError: true,
+		},
+		"endpoint ARN": {
+			value:       "arn:aws:rds:us-west-2:123456789012:cluster-endpoint:test",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			got, err := flattenClusterARN(testCase.value)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.value {
+				t.Errorf("got %q, want %q", got, testCase.value)
+			}
+		})
+	}
+}
+
+func TestClusterARNFromEndpointARN(t *testing.T) {
+	got, err := clusterARNFromEndpointARN("arn:aws-us-gov:rds:us-gov-west-1:123456789012:cluster-endpoint:test", "my-cluster")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "arn:aws-us-gov:rds:us-gov-west-1:123456789012:cluster:my-cluster"; got != expected {
+		t.Errorf("got %q, want %q", got, expected)
+	}
+
+	if _, err := clusterARNFromEndpointARN("cluster-endpoint:test", "my-cluster"); err == nil {
+		t.Error("expected error for malformed endpoint ARN")
+	}
+}
+
+func TestFlattenClusterEndpointARN(t *testing.T) {
+	testCases := map[string]struct {
+		value       string
+		expected    string
+		expectError bool
+	}{
+		"valid": {
+			value:    "arn:aws:rds:us-west-2:123456789012:cluster-endpoint:test",
+			expected: "arn:aws:rds:us-west-2:123456789012:cluster-endpoint:test",
+		},
+		"other partition": {
+			value:    "arn:aws-us-gov:rds:us-gov-west-1:123456789012:cluster-endpoint:test",
+			expected: "arn:aws-us-gov:rds:us-gov-west-1:123456789012:cluster-endpoint:test",
+		},
+		"malformed": {
+			value:       "cluster-endpoint:test",
+			expectError: true,
+		},
+		"cluster ARN": {
+			value:       "arn:aws:rds:us-west-2:123456789012:cluster:test",
+			expectError: true,
+		},
+		"other service": {
+			value:       "arn:aws:neptune-db:us-west-2:123456789012:cluster-endpoint:test",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			got, err := flattenClusterEndpointARN(testCase.value)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMergeClusterEndpointMembers(t *testing.T) {
+	testCases := map[string]struct {
+		current, old, new []string
+		expected          []string
+	}{
+		"add to members of other owners": {
+			current:  []string{"other", "a"},
+			old:      []string{"a"},
+			new:      []string{"a", "b"},
+			expected: []string{"a", "b", "other"},
+		},
+		"remove only managed members": {
+			current:  []string{"other", "a", "b"},
+			old:      []string{"a", "b"},
+			new:      []string{"b"},
+			expected: []string{"b", "other"},
+		},
+		"remove all managed members": {
+			current:  []string{"a"},
+			old:      []string{"a"},
+			expected: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			if got := mergeClusterEndpointMembers(testCase.current, testCase.old, testCase.new); !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterEndpointManagedMembers(t *testing.T) {
+	got := clusterEndpointManagedMembers([]string{"a", "b", "gone"}, []string{"b", "other", "a"})
+
+	if expected := []string{"b", "a"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %q, want %q", got, expected)
+	}
+}
+
+func TestGlobalClusterMajorVersionUpgrade(t *testing.T) {
+	testCases := map[string]struct {
+		oldVersion string
+		newVersion string
+		expected   bool
+	}{
+		"minor": {
+			oldVersion: "1.2.0.0",
+			newVersion: "1.2.1.0",
+		},
+		"major": {
+			oldVersion: "1.1.1.0",
+			newVersion: "1.2.0.0",
+			expected:   true,
+		},
+		"unknown": {
+			newVersion: "1.2.0.0",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			if got := globalClusterMajorVersionUpgrade(testCase.oldVersion, testCase.newVersion); got != testCase.expected {
+				t.Errorf("got %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterEndpointMatchesCreateInput(t *testing.T) {
+	input := &neptune.CreateDBClusterEndpointInput{
+		EndpointType:  aws.String(clusterEndpointTypeReader),
+		StaticMembers: aws.StringSlice([]string{"a", "b"}),
+	}
+
+	testCases := map[string]struct {
+		endpoint *neptune.DBClusterEndpoint
+		expected bool
+	}{
+		"same": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String(clusterEndpointTypeReader),
+				StaticMembers:      aws.StringSlice([]string{"b", "a"}),
+			},
+			expected: true,
+		},
+		"different type": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String(clusterEndpointTypeAny),
+				StaticMembers:      aws.StringSlice([]string{"a", "b"}),
+			},
+		},
+		"different static members": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String(clusterEndpointTypeReader),
+				StaticMembers:      aws.StringSlice([]string{"a"}),
+			},
+		},
+		"excluded members": {
+			endpoint: &neptune.DBClusterEndpoint{
+				CustomEndpointType: aws.String(clusterEndpointTypeReader),
+				StaticMembers:      aws.StringSlice([]string{"a", "b"}),
+				ExcludedMembers:    aws.StringSlice([]string{"c"}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			if got := clusterEndpointMatchesCreateInput(testCase.endpoint, input); got != testCase.expected {
+				t.Errorf("got %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package neptune
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGlobalCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGlobalClusterCreate,
+		Read:   resourceGlobalClusterRead,
+		Update: resourceGlobalClusterUpdate,
+		Delete: resourceGlobalClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(GlobalClusterAvailableTimeout),
+			Update: schema.DefaultTimeout(GlobalClusterUpdatedTimeout),
+			Delete: schema.DefaultTimeout(GlobalClusterDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"engine": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_db_cluster_identifier"},
+				ValidateFunc:  validEngine(),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"global_cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_cluster_members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_cluster_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_writer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"global_cluster_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_cluster_identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"engine"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGlobalClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	input := &neptune.CreateGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(d.Get("global_cluster_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("deletion_protection"); ok {
+		input.DeletionProtection = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("engine"); ok {
+		input.Engine = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_db_cluster_identifier"); ok {
+		input.SourceDBClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_encrypted"); ok {
+		input.StorageEncrypted = aws.Bool(v.(bool))
+	}
+
+	// A standalone global cluster requires the engine, which the API can
+	// otherwise take from the source cluster.
+	if input.Engine == nil && input.SourceDBClusterIdentifier == nil {
+		input.Engine = aws.String("neptune")
+	}
+
+	log.Printf("[DEBUG] Creating Neptune Global Cluster: %s", input)
+	output, err := conn.CreateGlobalCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("creating Neptune Global Cluster (%s): %w", aws.StringValue(input.GlobalClusterIdentifier), err)
+	}
+
+	d.SetId(aws.StringValue(output.GlobalCluster.GlobalClusterIdentifier))
+
+	if _, err := WaitGlobalClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for Neptune Global Cluster (%s) to be Available: %w", d.Id(), err)
+	}
+
+	return resourceGlobalClusterRead(d, meta)
+}
+
+func resourceGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	globalCluster, err := FindGlobalClusterByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Neptune Global Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("describing Neptune Global Cluster (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(globalCluster.Status); !d.IsNewResource() && (status == GlobalClusterStatusDeleting || status == GlobalClusterStatusDeleted) {
+		log.Printf("[WARN] Neptune Global Cluster (%s) in deleted state (%s), removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", globalCluster.GlobalClusterArn)
+	d.Set("deletion_protection", globalCluster.DeletionProtection)
+	d.Set("engine", globalCluster.Engine)
+	d.Set("engine_version", globalCluster.EngineVersion)
+	d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+	if err := d.Set("global_cluster_members", flattenGlobalClusterMembers(globalCluster.GlobalClusterMembers)); err != nil {
+		return fmt.Errorf("setting global_cluster_members: %w", err)
+	}
+	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("status", globalCluster.Status)
+	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
+
+	return nil
+}
+
+func resourceGlobalClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	input := &neptune.ModifyGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(d.Id()),
+	}
+
+	if d.HasChange("deletion_protection") {
+		input.DeletionProtection = aws.Bool(d.Get("deletion_protection").(bool))
+	}
+
+	if d.HasChange("engine_version") {
+		o, n := d.GetChange("engine_version")
+		input.EngineVersion = aws.String(n.(string))
+		input.AllowMajorVersionUpgrade = aws.Bool(globalClusterMajorVersionUpgrade(o.(string), n.(string)))
+	}
+
+	log.Printf("[DEBUG] Updating Neptune Global Cluster (%s): %s", d.Id(), input)
+	_, err := retryWhenGlobalClusterStateInvalid(d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		return conn.ModifyGlobalCluster(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating Neptune Global Cluster (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitGlobalClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("waiting for Neptune Global Cluster (%s) to be Available: %w", d.Id(), err)
+	}
+
+	return resourceGlobalClusterRead(d, meta)
+}
+
+func resourceGlobalClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	input := &neptune.DeleteGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Neptune Global Cluster: %s", d.Id())
+	_, err := conn.DeleteGlobalCluster(input)
+
+	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeGlobalClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Neptune Global Cluster (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitGlobalClusterDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for Neptune Global Cluster (%s) to be Deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// retryWhenGlobalClusterStateInvalid retries f while the global cluster or one
+// of its members is busy, e.g. while a member is being added or removed.
+func retryWhenGlobalClusterStateInvalid(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return tfresource.RetryWhenAWSErrCodeEquals(timeout, f, neptune.ErrCodeInvalidGlobalClusterStateFault)
+}
@@ -0,0 +1,291 @@
+package neptune_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfneptune "github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccNeptuneGlobalCluster_basic(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "rds", regexp.MustCompile(`global-cluster:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "neptune"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "1.2.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "global_cluster_resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGlobalCluster_disappears(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					acctest.CheckResourceDisappears(acctest.Provider, tfneptune.ResourceGlobalCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGlobalCluster_deletionProtection(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_deletionProtection(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalClusterConfig_deletionProtection(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGlobalCluster_sourceDBClusterIdentifier(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	clusterResourceName := "aws_neptune_cluster.test"
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_sourceDBClusterIdentifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_identifier", clusterResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", clusterResourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "global_cluster_members.0.db_cluster_arn", clusterResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.0.is_writer", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_db_cluster_identifier"},
+			},
+			{
+				// The global cluster can only be destroyed without members.
+				Config: testAccGlobalClusterConfig_sourceDBClusterIdentifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterRemoveMember(resourceName, clusterResourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalClusterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_neptune_global_cluster" {
+			continue
+		}
+
+		globalCluster, err := tfneptune.FindGlobalClusterByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(globalCluster.Status); status == tfneptune.GlobalClusterStatusDeleted {
+			continue
+		}
+
+		return fmt.Errorf("Neptune Global Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGlobalClusterExists(n string, v *neptune.GlobalCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Neptune Global Cluster ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+
+		output, err := tfneptune.FindGlobalClusterByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("Neptune Global Cluster (%s) not found: %w", rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCheckGlobalClusterRemoveMember removes the cluster from the global
+// cluster and waits until it's no longer reported as a member.
+func testAccCheckGlobalClusterRemoveMember(n, clusterName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		clusterRs, ok := s.RootModule().Resources[clusterName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", clusterName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+		clusterARN := clusterRs.Primary.Attributes["arn"]
+
+		_, err := conn.RemoveFromGlobalCluster(&neptune.RemoveFromGlobalClusterInput{
+			DbClusterIdentifier:     aws.String(clusterARN),
+			GlobalClusterIdentifier: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("removing Neptune Cluster (%s) from Neptune Global Cluster (%s): %w", clusterARN, rs.Primary.ID, err)
+		}
+
+		return resource.Retry(tfneptune.GlobalClusterDeletedTimeout, func() *resource.RetryError {
+			globalCluster, err := tfneptune.FindGlobalClusterByID(conn, rs.Primary.ID)
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			for _, member := range globalCluster.GlobalClusterMembers {
+				if aws.StringValue(member.DBClusterArn) == clusterARN {
+					return resource.RetryableError(fmt.Errorf("Neptune Cluster (%s) still a member of Neptune Global Cluster (%s)", clusterARN, rs.Primary.ID))
+				}
+			}
+
+			return nil
+		})
+	}
+}
+
+func testAccGlobalClusterConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine_version            = "1.2.0.0"
+}
+`, rName)
+}
+
+func testAccGlobalClusterConfig_deletionProtection(rName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine_version            = "1.2.0.0"
+  deletion_protection       = %[2]t
+}
+`, rName, deletionProtection)
+}
+
+func testAccGlobalClusterConfig_sourceDBClusterIdentifier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier                   = %[1]q
+  engine                               = "neptune"
+  engine_version                       = "1.2.0.0"
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+  skip_final_snapshot                  = true
+}
+
+resource "aws_neptune_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_neptune_cluster.test.id
+  instance_class     = "db.r5.large"
+  engine_version     = aws_neptune_cluster.test.engine_version
+}
+
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier    = %[1]q
+  source_db_cluster_identifier = aws_neptune_cluster.test.arn
+
+  depends_on = [aws_neptune_cluster_instance.test]
+}
+`, rName)
+}
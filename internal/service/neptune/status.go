@@ -1,9 +1,13 @@
 package neptune
 
 import (
+	"log"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -22,6 +26,33 @@ const (
 
 	// DBClusterEndpoint Unknown
 	DBClusterEndpointStatusUnknown = "Unknown"
+
+	// DBClusterEndpoint Deleting
+	DBClusterEndpointStatusDeleting = "deleting"
+
+	// DBClusterEndpoint members match the requested members
+	DBClusterEndpointMembersStatusInSync = "InSync"
+
+	// DBClusterEndpoint members don't yet match the requested members
+	DBClusterEndpointMembersStatusSyncing = "Syncing"
+
+	// GlobalCluster Available
+	GlobalClusterStatusAvailable = "available"
+
+	// GlobalCluster Creating
+	GlobalClusterStatusCreating = "creating"
+
+	// GlobalCluster Deleted
+	GlobalClusterStatusDeleted = "deleted"
+
+	// GlobalCluster Deleting
+	GlobalClusterStatusDeleting = "deleting"
+
+	// GlobalCluster Modifying
+	GlobalClusterStatusModifying = "modifying"
+
+	// GlobalCluster Upgrading
+	GlobalClusterStatusUpgrading = "upgrading"
 )
 
 // StatusEventSubscription fetches the EventSubscription and its Status
@@ -84,3 +115,70 @@ func StatusDBClusterEndpoint(conn *neptune.Neptune, id string) resource.StateRef
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+// statusDBClusterEndpointDeleting wraps a DBClusterEndpoint status refresh so that
+// non-NotFound errors are retried, up to maxErrors consecutive times, while the
+// endpoint is being deleted. The parent cluster may be deleted concurrently, in
+// which case describe calls can fail transiently before returning NotFound.
+func statusDBClusterEndpointDeleting(refresh resource.StateRefreshFunc, maxErrors int) resource.StateRefreshFunc {
+	var errCount int
+
+	return func() (interface{}, string, error) {
+		output, status, err := refresh()
+
+		if err != nil {
+			errCount++
+
+			if errCount > maxErrors {
+				return nil, status, err
+			}
+
+			log.Printf("[WARN] Retrying Neptune Cluster Endpoint status after error (%d/%d): %s", errCount, maxErrors, err)
+
+			return &neptune.DBClusterEndpoint{}, DBClusterEndpointStatusDeleting, nil
+		}
+
+		errCount = 0
+
+		return output, status, nil
+	}
+}
+
+// statusDBClusterEndpointMembers reports whether the DBClusterEndpoint's static and
+// excluded members match the given sets
+func statusDBClusterEndpointMembers(conn *neptune.Neptune, id string, staticMembers, excludedMembers *schema.Set) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEndpointByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, DBClusterEndpointStatusUnknown, err
+		}
+
+		if !staticMembers.Equal(flex.FlattenStringSet(output.StaticMembers)) || !excludedMembers.Equal(flex.FlattenStringSet(output.ExcludedMembers)) {
+			return output, DBClusterEndpointMembersStatusSyncing, nil
+		}
+
+		return output, DBClusterEndpointMembersStatusInSync, nil
+	}
+}
+
+// StatusGlobalCluster fetches the GlobalCluster and its Status
+func StatusGlobalCluster(conn *neptune.Neptune, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
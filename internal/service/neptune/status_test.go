@@ -0,0 +1,80 @@
+package neptune
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+type testRefreshResult struct {
+	output interface{}
+	status string
+	err    error
+}
+
+func testScriptedRefresh(results []testRefreshResult) resource.StateRefreshFunc {
+	var i int
+
+	return func() (interface{}, string, error) {
+		result := results[i]
+		if i < len(results)-1 {
+			i++
+		}
+		return result.output, result.status, result.err
+	}
+}
+
+func TestStatusDBClusterEndpointDeleting(t *testing.T) {
+	deleting := testRefreshResult{
+		output: &neptune.DBClusterEndpoint{Status: aws.String(DBClusterEndpointStatusDeleting)},
+		status: DBClusterEndpointStatusDeleting,
+	}
+	transient := testRefreshResult{
+		status: DBClusterEndpointStatusUnknown,
+		err:    errors.New("InternalFailure: cluster is being deleted"),
+	}
+	notFound := testRefreshResult{}
+
+	testCases := map[string]struct {
+		results     []testRefreshResult
+		expectError bool
+	}{
+		"deleted": {
+			results: []testRefreshResult{deleting, notFound},
+		},
+		"cluster deleted concurrently": {
+			results: []testRefreshResult{deleting, transient, transient, notFound},
+		},
+		"persistent error": {
+			results:     []testRefreshResult{deleting, transient},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			stateConf := &resource.StateChangeConf{
+				Pending:      []string{DBClusterEndpointStatusDeleting},
+				Target:       []string{},
+				Refresh:      statusDBClusterEndpointDeleting(testScriptedRefresh(testCase.results), 3),
+				Timeout:      time.Minute,
+				PollInterval: time.Millisecond,
+			}
+
+			_, err := stateConf.WaitForState()
+
+			if testCase.expectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
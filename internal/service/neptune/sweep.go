@@ -17,10 +17,95 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_neptune_cluster_endpoint", &resource.Sweeper{
+		Name: "aws_neptune_cluster_endpoint",
+		F:    sweepClusterEndpoints,
+	})
+
 	resource.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
 		Name: "aws_neptune_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
+
+	resource.AddTestSweepers("aws_neptune_global_cluster", &resource.Sweeper{
+		Name: "aws_neptune_global_cluster",
+		F:    sweepGlobalClusters,
+	})
+}
+
+// clusterEndpointSweepConcurrency is the number of cluster endpoints that are
+// deleted, and waited on, at the same time. Raise it to sweep large accounts
+// faster, or lower it if the deletes are throttled.
+const clusterEndpointSweepConcurrency = 10
+
+// sweepClusterEndpoints deletes every custom endpoint in the region. A single
+// paginated describe without a cluster filter lists the endpoints of all
+// clusters, and the deletes and their waits run in batches of
+// clusterEndpointSweepConcurrency.
+func sweepClusterEndpoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).NeptuneConn
+	input := &neptune.DescribeDBClusterEndpointsInput{
+		Filters: []*neptune.Filter{
+			{
+				Name:   aws.String("db-cluster-endpoint-type"),
+				Values: aws.StringSlice([]string{"custom"}),
+			},
+		},
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeDBClusterEndpointsPages(input, func(page *neptune.DescribeDBClusterEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, endpoint := range page.DBClusterEndpoints {
+			// The filter should already exclude the built-in reader and writer endpoints.
+			if endpoint == nil || aws.StringValue(endpoint.EndpointType) != clusterEndpointTypeCustom {
+				continue
+			}
+
+			r := ResourceClusterEndpoint()
+			d := r.Data(nil)
+			d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(endpoint.DBClusterIdentifier), aws.StringValue(endpoint.DBClusterEndpointIdentifier)))
+			d.Set("cluster_endpoint_identifier", endpoint.DBClusterEndpointIdentifier)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Neptune Cluster Endpoint sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Neptune Cluster Endpoints (%s): %w", region, err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	for len(sweepResources) > 0 {
+		n := clusterEndpointSweepConcurrency
+		if n > len(sweepResources) {
+			n = len(sweepResources)
+		}
+
+		// The orchestrator retries throttled deletes.
+		if err := sweep.SweepOrchestrator(sweepResources[:n]); err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping Neptune Cluster Endpoints (%s): %w", region, err))
+		}
+
+		sweepResources = sweepResources[n:]
+	}
+
+	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepEventSubscriptions(region string) error {
@@ -77,3 +162,45 @@ func sweepEventSubscriptions(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepGlobalClusters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).NeptuneConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	globalClusters, err := FindGlobalClusters(conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Neptune Global Cluster sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Neptune Global Clusters (%s): %w", region, err)
+	}
+
+	for _, globalCluster := range globalClusters {
+		id := aws.StringValue(globalCluster.GlobalClusterIdentifier)
+
+		// A global cluster can only be deleted once its member clusters are gone.
+		if len(globalCluster.GlobalClusterMembers) > 0 {
+			log.Printf("[INFO] Skipping Neptune Global Cluster %s: it has member clusters", id)
+			continue
+		}
+
+		r := ResourceGlobalCluster()
+		d := r.Data(nil)
+		d.SetId(id)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		return fmt.Errorf("sweeping Neptune Global Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
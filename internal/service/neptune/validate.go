@@ -3,7 +3,10 @@ package neptune
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -63,8 +66,56 @@ func validIdentifier(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// validClusterEndpointImportID checks that an import ID is a
+// CLUSTER-ID:ENDPOINT-ID pair of valid identifiers.
+func validClusterEndpointImportID(id string) (string, string, error) {
+	clusterID, endpointID, err := readClusterEndpointID(id)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	if _, errs := validIdentifier(clusterID, "cluster identifier"); len(errs) > 0 {
+		return "", "", fmt.Errorf("invalid import ID %q: %w", id, errs[0])
+	}
+
+	if _, errs := validIdentifier(endpointID, "endpoint identifier"); len(errs) > 0 {
+		return "", "", fmt.Errorf("invalid import ID %q: %w", id, errs[0])
+	}
+
+	return clusterID, endpointID, nil
+}
+
+// validClusterEndpointImportARN returns the endpoint identifier of a cluster
+// endpoint ARN, e.g. as copied from the AWS console. The ARN doesn't name the
+// endpoint's cluster.
+func validClusterEndpointImportARN(id string) (string, error) {
+	parsed, err := arn.Parse(id)
+
+	if err != nil {
+		return "", fmt.Errorf("invalid import ID %q: %w", id, err)
+	}
+
+	endpointID := strings.TrimPrefix(parsed.Resource, "cluster-endpoint:")
+
+	if parsed.Service != "rds" || endpointID == parsed.Resource {
+		return "", fmt.Errorf("invalid import ID %q: not a Neptune Cluster Endpoint ARN", id)
+	}
+
+	if _, errs := validIdentifier(endpointID, "endpoint identifier"); len(errs) > 0 {
+		return "", fmt.Errorf("invalid import ID %q: %w", id, errs[0])
+	}
+
+	return endpointID, nil
+}
+
 func validIdentifierPrefix(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
+	prefixMaxLength := 63 - resource.UniqueIDSuffixLength
+	if len(value) > prefixMaxLength {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than %d characters", k, prefixMaxLength))
+	}
 	if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
 		errors = append(errors, fmt.Errorf(
 			"only lowercase alphanumeric characters and hyphens allowed in %q", k))
@@ -157,3 +208,25 @@ func validSubnetGroupNamePrefix(v interface{}, k string) (ws []string, errors []
 	}
 	return
 }
+
+// clusterEndpointStaticMembersExplicitlyEmpty returns whether the configuration
+// sets the named static members attribute to an empty list, as opposed to
+// omitting it.
+func clusterEndpointStaticMembersExplicitlyEmpty(rawConfig cty.Value, name string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().HasAttribute(name) {
+		return false
+	}
+
+	v := rawConfig.GetAttr(name)
+
+	return v.IsKnown() && !v.IsNull() && v.LengthInt() == 0
+}
+
+// tagsWhollyKnown returns whether every value of the configured tags is known.
+func tagsWhollyKnown(rawConfig cty.Value) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return true
+	}
+
+	return rawConfig.GetAttr("tags").IsWhollyKnown()
+}
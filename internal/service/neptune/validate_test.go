@@ -1,9 +1,19 @@
 package neptune
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/go-cty/cty"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestValidEventSubscriptionName(t *testing.T) {
@@ -66,6 +76,40 @@ func TestValidEventSubscriptionNamePrefix(t *testing.T) {
 	}
 }
 
+func TestValidIdentifierPrefix(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "tf-test-",
+			ErrCount: 0,
+		},
+		{
+			Value:    "tf_test",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1test",
+			ErrCount: 1,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(37, sdkacctest.CharSetAlpha),
+			ErrCount: 0,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(38, sdkacctest.CharSetAlpha),
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validIdentifierPrefix(tc.Value, "cluster_endpoint_identifier_prefix")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
 func TestValidParamGroupName(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -209,3 +253,423 @@ func TestValidSubnetGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestClusterEndpointStaticMembersExplicitlyEmpty(t *testing.T) {
+	config := func(staticMembers cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"static_members": staticMembers})
+	}
+
+	testCases := map[string]struct {
+		rawConfig cty.Value
+		expected  bool
+	}{
+		"null config": {
+			rawConfig: cty.NullVal(cty.Object(map[string]cty.Type{"static_members": cty.Set(cty.String)})),
+		},
+		"omitted": {
+			rawConfig: config(cty.NullVal(cty.Set(cty.String))),
+		},
+		"empty": {
+			rawConfig: config(cty.SetValEmpty(cty.String)),
+			expected:  true,
+		},
+		"members": {
+			rawConfig: config(cty.SetVal([]cty.Value{cty.StringVal("instance-1")})),
+		},
+		"unknown": {
+			rawConfig: config(cty.UnknownVal(cty.Set(cty.String))),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			if got := clusterEndpointStaticMembersExplicitlyEmpty(testCase.rawConfig, "static_members"); got != testCase.expected {
+				t.Errorf("got %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterEndpointStaticMembersExplicitlyEmpty_ordered(t *testing.T) {
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"static_members":         cty.NullVal(cty.Set(cty.String)),
+		"static_members_ordered": cty.ListValEmpty(cty.String),
+	})
+
+	if clusterEndpointStaticMembersExplicitlyEmpty(rawConfig, "static_members") {
+		t.Error("static_members: got true, want false")
+	}
+
+	if !clusterEndpointStaticMembersExplicitlyEmpty(rawConfig, "static_members_ordered") {
+		t.Error("static_members_ordered: got false, want true")
+	}
+
+	if clusterEndpointStaticMembersExplicitlyEmpty(cty.ObjectVal(map[string]cty.Value{"static_members": cty.NullVal(cty.Set(cty.String))}), "static_members_ordered") {
+		t.Error("attribute not in config: got true, want false")
+	}
+}
+
+func TestValidClusterEndpointImportID(t *testing.T) {
+	testCases := map[string]struct {
+		id                 string
+		expectedClusterID  string
+		expectedEndpointID string
+		expectError        string
+	}{
+		"valid": {
+			id:                 "my-cluster:my-endpoint",
+			expectedClusterID:  "my-cluster",
+			expectedEndpointID: "my-endpoint",
+		},
+		"endpoint only": {
+			id:          "my-endpoint",
+			expectError: "expected ID in format clusterIdentifier:endpointIndetifer",
+		},
+		"too many parts": {
+			id:          "my-cluster:my-endpoint:extra",
+			expectError: "expected ID in format clusterIdentifier:endpointIndetifer",
+		},
+		"empty cluster": {
+			id:          ":my-endpoint",
+			expectError: `"cluster identifier"`,
+		},
+		"invalid endpoint": {
+			id:          "my-cluster:My_Endpoint",
+			expectError: `"endpoint identifier"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			clusterID, endpointID, err := validClusterEndpointImportID(testCase.id)
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("got error %v, want error containing %q", err, testCase.expectError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if clusterID != testCase.expectedClusterID || endpointID != testCase.expectedEndpointID {
+				t.Errorf("got %q, %q, want %q, %q", clusterID, endpointID, testCase.expectedClusterID, testCase.expectedEndpointID)
+			}
+		})
+	}
+}
+
+func TestValidClusterEndpointImportARN(t *testing.T) {
+	testCases := map[string]struct {
+		id                 string
+		expectedEndpointID string
+		expectError        string
+	}{
+		"valid": {
+			id:                 "arn:aws:rds:us-west-2:123456789012:cluster-endpoint:my-endpoint",
+			expectedEndpointID: "my-endpoint",
+		},
+		"cluster ARN": {
+			id:          "arn:aws:rds:us-west-2:123456789012:cluster:my-cluster",
+			expectError: "not a Neptune Cluster Endpoint ARN",
+		},
+		"other service": {
+			id:          "arn:aws:ec2:us-west-2:123456789012:cluster-endpoint:my-endpoint",
+			expectError: "not a Neptune Cluster Endpoint ARN",
+		},
+		"invalid endpoint": {
+			id:          "arn:aws:rds:us-west-2:123456789012:cluster-endpoint:My_Endpoint",
+			expectError: `"endpoint identifier"`,
+		},
+		"not an ARN": {
+			id:          "my-cluster:my-endpoint",
+			expectError: "invalid import ID",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			endpointID, err := validClusterEndpointImportARN(testCase.id)
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("got error %v, want error containing %q", err, testCase.expectError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if endpointID != testCase.expectedEndpointID {
+				t.Errorf("got %q, want %q", endpointID, testCase.expectedEndpointID)
+			}
+		})
+	}
+}
+
+func TestClusterEndpointTagsSupported(t *testing.T) {
+	testCases := map[string]bool{
+		endpoints.AwsPartitionID:      true,
+		endpoints.AwsUsGovPartitionID: true,
+		endpoints.AwsIsoPartitionID:   true,
+		endpoints.AwsIsoBPartitionID:  true,
+		endpoints.AwsCnPartitionID:    false,
+	}
+
+	for partition, expected := range testCases {
+		if got := clusterEndpointTagsSupported(partition); got != expected {
+			t.Errorf("clusterEndpointTagsSupported(%q) = %t, want %t", partition, got, expected)
+		}
+	}
+}
+
+// mockClusterEndpointTagsConn returns a client in the region whose calls fail
+// with the error given for their operation, and records the inputs of the
+// calls.
+func mockClusterEndpointTagsConn(t *testing.T, region string, errs map[string]error) (*neptune.Neptune, *[]interface{}) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		Region:      aws.String(region),
+	})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := neptune.New(sess)
+	var inputs []interface{}
+
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		inputs = append(inputs, r.Params)
+
+		if err, ok := errs[r.Operation.Name]; ok {
+			// Only the first call of an operation fails.
+			delete(errs, r.Operation.Name)
+			r.Error = err
+			return
+		}
+
+		switch output := r.Data.(type) {
+		case *neptune.CreateDBClusterEndpointOutput:
+			output.DBClusterEndpointIdentifier = aws.String("test")
+		case *neptune.ListTagsForResourceOutput:
+			output.TagList = []*neptune.Tag{{Key: aws.String("key"), Value: aws.String("listed")}}
+		}
+	})
+
+	return conn, &inputs
+}
+
+func TestSendDBClusterEndpointCreateWithTags(t *testing.T) {
+	errAccessDenied := awserr.NewRequestFailure(awserr.New("AccessDenied", "tagging not supported", nil), 400, "")
+
+	testCases := map[string]struct {
+		region      string
+		expectCalls int
+		expectError bool
+	}{
+		"commercial": {
+			region:      endpoints.UsEast1RegionID,
+			expectCalls: 1,
+			expectError: true,
+		},
+		"GovCloud retries without tags": {
+			region:      endpoints.UsGovWest1RegionID,
+			expectCalls: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			conn, inputs := mockClusterEndpointTagsConn(t, testCase.region, map[string]error{"CreateDBClusterEndpoint": errAccessDenied})
+			input := &neptune.CreateDBClusterEndpointInput{
+				DBClusterEndpointIdentifier: aws.String("test"),
+				Tags:                        []*neptune.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+			}
+
+			output, _, err := sendDBClusterEndpointCreateWithTags(conn, input)
+
+			if len(*inputs) != testCase.expectCalls {
+				t.Errorf("got %d calls, want %d", len(*inputs), testCase.expectCalls)
+			}
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := aws.StringValue(output.DBClusterEndpointIdentifier); got != "test" {
+				t.Errorf("got endpoint %q, want %q", got, "test")
+			}
+
+			if input.Tags != nil {
+				t.Errorf("got tags %v on the retried create, want none", input.Tags)
+			}
+		})
+	}
+}
+
+func TestResourceClusterEndpointReadTags(t *testing.T) {
+	errAccessDenied := awserr.NewRequestFailure(awserr.New("AccessDenied", "tagging not supported", nil), 400, "")
+
+	testCases := map[string]struct {
+		errs        map[string]error
+		expectTag   string
+		expectError bool
+	}{
+		"listed": {
+			expectTag: "listed",
+		},
+		"unsupported keeps state": {
+			errs:      map[string]error{"ListTagsForResource": errAccessDenied},
+			expectTag: "state",
+		},
+		"other error": {
+			errs:        map[string]error{"ListTagsForResource": awserr.NewRequestFailure(awserr.New(neptune.ErrCodeDBClusterNotFoundFault, "not found", nil), 404, "")},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			conn, _ := mockClusterEndpointTagsConn(t, endpoints.UsGovWest1RegionID, testCase.errs)
+			d := ResourceClusterEndpoint().Data(nil)
+			d.Set("tags", map[string]interface{}{"key": "state"})
+			d.Set("tags_all", map[string]interface{}{"key": "state"})
+
+			err := resourceClusterEndpointReadTags(d, conn, "arn:aws-us-gov:rds:us-gov-west-1:123456789012:cluster-endpoint:test", &tftags.DefaultConfig{}, &tftags.IgnoreConfig{})
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, k := range []string{"tags", "tags_all"} {
+				if got := d.Get(k).(map[string]interface{})["key"]; got != testCase.expectTag {
+					t.Errorf("got %s %q, want %q", k, got, testCase.expectTag)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateClusterEndpointTags(t *testing.T) {
+	errAccessDenied := awserr.NewRequestFailure(awserr.New("AccessDenied", "tagging not supported", nil), 400, "")
+
+	testCases := map[string]struct {
+		region          string
+		err             error
+		onlyDefaultTags bool
+		expectUpdated   bool
+		expectError     bool
+	}{
+		"updated": {
+			region:        endpoints.UsGovWest1RegionID,
+			expectUpdated: true,
+		},
+		"default tags only continue": {
+			region:          endpoints.UsGovWest1RegionID,
+			err:             errAccessDenied,
+			onlyDefaultTags: true,
+		},
+		"configured tags fail": {
+			region:      endpoints.UsGovWest1RegionID,
+			err:         errAccessDenied,
+			expectError: true,
+		},
+		"commercial default tags fail": {
+			region:          endpoints.UsEast1RegionID,
+			err:             errAccessDenied,
+			onlyDefaultTags: true,
+			expectError:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			conn, _ := mockClusterEndpointTagsConn(t, testCase.region, map[string]error{"AddTagsToResource": testCase.err})
+
+			updated, err := updateClusterEndpointTags(conn, "arn", map[string]interface{}{}, map[string]interface{}{"key": "value"}, testCase.onlyDefaultTags)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if updated != testCase.expectUpdated {
+				t.Errorf("got updated %t, want %t", updated, testCase.expectUpdated)
+			}
+		})
+	}
+}
+
+func TestTagsWhollyKnown(t *testing.T) {
+	config := func(tags cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"tags": tags})
+	}
+
+	testCases := map[string]struct {
+		rawConfig cty.Value
+		expected  bool
+	}{
+		"null config": {
+			rawConfig: cty.NullVal(cty.Object(map[string]cty.Type{"tags": cty.Map(cty.String)})),
+			expected:  true,
+		},
+		"no tags": {
+			rawConfig: config(cty.NullVal(cty.Map(cty.String))),
+			expected:  true,
+		},
+		"known": {
+			rawConfig: config(cty.MapVal(map[string]cty.Value{"key1": cty.StringVal("value1")})),
+			expected:  true,
+		},
+		"one value unknown": {
+			rawConfig: config(cty.MapVal(map[string]cty.Value{
+				"key1": cty.StringVal("value1"),
+				"key2": cty.UnknownVal(cty.String),
+			})),
+		},
+		"map unknown": {
+			rawConfig: config(cty.UnknownVal(cty.Map(cty.String))),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			if got := tagsWhollyKnown(testCase.rawConfig); got != testCase.expected {
+				t.Errorf("got %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
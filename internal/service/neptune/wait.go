@@ -1,10 +1,17 @@
 package neptune
 
 import (
+	"errors"
+	"log"
+	"math/rand"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
@@ -16,6 +23,22 @@ const (
 
 	// Maximum amount of time to wait for an DBClusterEndpoint to return Deleted
 	DBClusterEndpointDeletedTimeout = 10 * time.Minute
+
+	// Maximum number of consecutive errors tolerated while waiting for an DBClusterEndpoint to return Deleted
+	dbClusterEndpointDeletedMaxErrors = 5
+
+	// Maximum amount of time to wait for an GlobalCluster to return Available after create
+	GlobalClusterAvailableTimeout = 30 * time.Minute
+
+	// Maximum amount of time to wait for an GlobalCluster to return Available after update
+	GlobalClusterUpdatedTimeout = 90 * time.Minute
+
+	// Maximum amount of time to wait for an GlobalCluster to return Deleted
+	GlobalClusterDeletedTimeout = 30 * time.Minute
+
+	// Bounds of the delay between attempts to create a DBClusterEndpoint while its cluster is busy
+	dbClusterEndpointCreateRetryMinDelay = 5 * time.Second
+	dbClusterEndpointCreateRetryMaxDelay = 30 * time.Second
 )
 
 // WaitEventSubscriptionDeleted waits for a EventSubscription to return Deleted
@@ -90,12 +113,12 @@ func WaitDBClusterAvailable(conn *neptune.Neptune, id string, timeout time.Durat
 }
 
 // WaitDBClusterEndpointAvailable waits for a DBClusterEndpoint to return Available
-func WaitDBClusterEndpointAvailable(conn *neptune.Neptune, id string) (*neptune.DBClusterEndpoint, error) {
+func WaitDBClusterEndpointAvailable(conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.DBClusterEndpoint, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"creating", "modifying"},
 		Target:  []string{"available"},
 		Refresh: StatusDBClusterEndpoint(conn, id),
-		Timeout: DBClusterEndpointAvailableTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -108,12 +131,12 @@ func WaitDBClusterEndpointAvailable(conn *neptune.Neptune, id string) (*neptune.
 }
 
 // WaitDBClusterEndpointDeleted waits for a DBClusterEndpoint to return Deleted
-func WaitDBClusterEndpointDeleted(conn *neptune.Neptune, id string) (*neptune.DBClusterEndpoint, error) {
+func WaitDBClusterEndpointDeleted(conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.DBClusterEndpoint, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"deleting"},
+		Pending: []string{DBClusterEndpointStatusDeleting},
 		Target:  []string{},
-		Refresh: StatusDBClusterEndpoint(conn, id),
-		Timeout: DBClusterEndpointDeletedTimeout,
+		Refresh: statusDBClusterEndpointDeleting(StatusDBClusterEndpoint(conn, id), dbClusterEndpointDeletedMaxErrors),
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -124,3 +147,176 @@ func WaitDBClusterEndpointDeleted(conn *neptune.Neptune, id string) (*neptune.DB
 
 	return nil, err
 }
+
+// WaitDBClusterEndpointInSync waits for a DBClusterEndpoint's static and excluded
+// members to match the requested sets
+func WaitDBClusterEndpointInSync(conn *neptune.Neptune, id string, staticMembers, excludedMembers *schema.Set, timeout time.Duration) (*neptune.DBClusterEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{DBClusterEndpointMembersStatusSyncing},
+		Target:  []string{DBClusterEndpointMembersStatusInSync},
+		Refresh: statusDBClusterEndpointMembers(conn, id, staticMembers, excludedMembers),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*neptune.DBClusterEndpoint); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// WaitGlobalClusterAvailable waits for a GlobalCluster to return Available
+func WaitGlobalClusterAvailable(conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalClusterStatusCreating,
+			GlobalClusterStatusModifying,
+			GlobalClusterStatusUpgrading,
+		},
+		Target:  []string{GlobalClusterStatusAvailable},
+		Refresh: StatusGlobalCluster(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// WaitGlobalClusterDeleted waits for a GlobalCluster to return Deleted
+func WaitGlobalClusterDeleted(conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalClusterStatusAvailable,
+			GlobalClusterStatusDeleting,
+		},
+		Target:  []string{},
+		Refresh: StatusGlobalCluster(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// retryWhenClusterStateInvalid retries f while it fails because the cluster is
+// in a transient state that can't accept endpoint changes, e.g. while an
+// instance is being added or an automated backup is running (backing-up), until
+// timeout expires. Neptune reports all of these states with the same fault.
+func retryWhenClusterStateInvalid(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return retryWhenClusterStateInvalidWithSleep(timeout, f, time.Sleep)
+}
+
+func retryWhenClusterStateInvalidWithSleep(timeout time.Duration, f func() (interface{}, error), sleep func(time.Duration)) (interface{}, error) {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 0; ; attempt++ {
+		output, err := f()
+
+		if !tfawserr.ErrCodeEquals(err, neptune.ErrCodeInvalidDBClusterStateFault) {
+			return output, err
+		}
+
+		delay := dbClusterEndpointCreateRetryDelay(timeout, attempt)
+
+		if time.Now().Add(delay).After(deadline) {
+			return nil, err
+		}
+
+		sleep(delay)
+	}
+}
+
+// adoptWhenAlreadyExistsOnRetry makes a retried create idempotent.
+// CreateDBClusterEndpoint takes no client token, and an attempt that failed
+// without an API error code, e.g. on a network error or timeout, may still have
+// created the endpoint. So if a later attempt, or a later SDK attempt of the
+// same request (create reports retried), fails because the endpoint already exists,
+// the result of adopt is returned instead. An attempt rejected with an API error
+// code, e.g. InvalidDBClusterStateFault, created nothing and doesn't allow
+// adoption, so an endpoint that existed before the create isn't silently taken
+// over.
+func adoptWhenAlreadyExistsOnRetry(create func() (output interface{}, retried bool, err error), adopt func() (interface{}, error)) func() (interface{}, error) {
+	var outcomeUnknown bool
+
+	return func() (interface{}, error) {
+		output, retried, err := create()
+
+		if (outcomeUnknown || retried) && tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterEndpointAlreadyExistsFault) {
+			log.Printf("[INFO] Neptune Cluster Endpoint was created by an earlier attempt, adopting it")
+			return adopt()
+		}
+
+		outcomeUnknown = outcomeUnknown || dbClusterEndpointCreateOutcomeUnknown(err)
+
+		return output, err
+	}
+}
+
+// sendDBClusterEndpointCreate sends a CreateDBClusterEndpoint request and reports,
+// as retried, whether one of its SDK attempts failed without an API error code.
+// SDK retries after a throttle or server error don't count, as the API
+// created nothing then.
+func sendDBClusterEndpointCreate(conn *neptune.Neptune, input *neptune.CreateDBClusterEndpointInput) (*neptune.CreateDBClusterEndpointOutput, bool, error) {
+	var retried bool
+
+	req, output := conn.CreateDBClusterEndpointRequest(input)
+	req.Handlers.CompleteAttempt.PushBack(func(r *request.Request) {
+		retried = retried || dbClusterEndpointCreateOutcomeUnknown(r.Error)
+	})
+
+	err := req.Send()
+
+	return output, retried, err
+}
+
+// dbClusterEndpointCreateOutcomeUnknown reports whether a create attempt failed
+// without an API error code, so that it may have created the endpoint. A
+// successful response that couldn't be read is also reported, with its 2xx
+// status code.
+func dbClusterEndpointCreateOutcomeUnknown(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var reqErr awserr.RequestFailure
+
+	if errors.As(err, &reqErr) {
+		return reqErr.StatusCode() < 300
+	}
+
+	return true
+}
+
+// dbClusterEndpointCreateRetryDelay returns the delay before the next attempt
+// of an endpoint create, update or delete. It starts at a fortieth of the timeout, doubles with each attempt and
+// stays between the minimum and maximum delays. A random half of it is jitter, so
+// that endpoints created together on a large cluster don't retry in lockstep.
+func dbClusterEndpointCreateRetryDelay(timeout time.Duration, attempt int) time.Duration {
+	delay := timeout / 40
+
+	for i := 0; i < attempt && delay < dbClusterEndpointCreateRetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay < dbClusterEndpointCreateRetryMinDelay {
+		delay = dbClusterEndpointCreateRetryMinDelay
+	}
+
+	if delay > dbClusterEndpointCreateRetryMaxDelay {
+		delay = dbClusterEndpointCreateRetryMaxDelay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
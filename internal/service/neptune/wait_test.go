@@ -0,0 +1,336 @@
+package neptune
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+func TestDBClusterEndpointCreateRetryDelay(t *testing.T) {
+	testCases := map[string]struct {
+		timeout  time.Duration
+		attempt  int
+		expected time.Duration
+	}{
+		"short timeout uses minimum": {
+			timeout:  1 * time.Minute,
+			expected: dbClusterEndpointCreateRetryMinDelay,
+		},
+		"derived from timeout": {
+			timeout:  10 * time.Minute,
+			expected: 15 * time.Second,
+		},
+		"doubles per attempt": {
+			timeout:  5 * time.Minute,
+			attempt:  1,
+			expected: 15 * time.Second,
+		},
+		"capped at maximum": {
+			timeout:  10 * time.Minute,
+			attempt:  10,
+			expected: dbClusterEndpointCreateRetryMaxDelay,
+		},
+		"long timeout capped at maximum": {
+			timeout:  2 * time.Hour,
+			expected: dbClusterEndpointCreateRetryMaxDelay,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				got := dbClusterEndpointCreateRetryDelay(testCase.timeout, testCase.attempt)
+
+				if got < testCase.expected/2 || got > testCase.expected {
+					t.Fatalf("got %s, want between %s and %s", got, testCase.expected/2, testCase.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryWhenClusterStateInvalid(t *testing.T) {
+	errInvalidState := awserr.New(neptune.ErrCodeInvalidDBClusterStateFault, "DB cluster is not in the available state", nil)
+
+	testCases := map[string]struct {
+		timeout     time.Duration
+		failures    int
+		err         error
+		expectCalls int
+		expectError string
+	}{
+		"succeeds after transient failures": {
+			timeout:     10 * time.Minute,
+			failures:    2,
+			err:         errInvalidState,
+			expectCalls: 3,
+		},
+		"not retryable": {
+			timeout:     10 * time.Minute,
+			failures:    1,
+			err:         awserr.New(neptune.ErrCodeDBClusterNotFoundFault, "not found", nil),
+			expectCalls: 1,
+			expectError: neptune.ErrCodeDBClusterNotFoundFault,
+		},
+		"gives up before the timeout": {
+			timeout:     1 * time.Second,
+			failures:    5,
+			err:         errInvalidState,
+			expectCalls: 1,
+			expectError: neptune.ErrCodeInvalidDBClusterStateFault,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			var slept []time.Duration
+
+			output, err := retryWhenClusterStateInvalidWithSleep(testCase.timeout, func() (interface{}, error) {
+				calls++
+
+				if calls <= testCase.failures {
+					return nil, testCase.err
+				}
+
+				return &neptune.CreateDBClusterEndpointOutput{}, nil
+			}, func(d time.Duration) {
+				slept = append(slept, d)
+			})
+
+			if calls != testCase.expectCalls {
+				t.Errorf("got %d calls, want %d", calls, testCase.expectCalls)
+			}
+
+			if len(slept) != calls-1 {
+				t.Errorf("got %d sleeps for %d calls", len(slept), calls)
+			}
+
+			if testCase.expectError != "" {
+				if !tfawserr.ErrCodeEquals(err, testCase.expectError) {
+					t.Fatalf("got error %v, want %s", err, testCase.expectError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _, ok := output.(*neptune.CreateDBClusterEndpointOutput); !ok {
+				t.Errorf("got output %T", output)
+			}
+		})
+	}
+}
+
+func TestAdoptWhenAlreadyExistsOnRetry(t *testing.T) {
+	errInvalidState := awserr.NewRequestFailure(awserr.New(neptune.ErrCodeInvalidDBClusterStateFault, "DB cluster is not in the available state", nil), 400, "")
+	errAlreadyExists := awserr.NewRequestFailure(awserr.New(neptune.ErrCodeDBClusterEndpointAlreadyExistsFault, "endpoint already exists", nil), 400, "")
+	adopted := &neptune.CreateDBClusterEndpointOutput{}
+
+	testCases := map[string]struct {
+		errs        []error
+		sdkRetried  bool
+		expectAdopt bool
+		expectError string
+	}{
+		"retry after a cluster state rejection": {
+			errs:        []error{errInvalidState, errAlreadyExists},
+			expectError: neptune.ErrCodeDBClusterEndpointAlreadyExistsFault,
+		},
+		"SDK retry after a network failure that succeeded": {
+			errs:        []error{errAlreadyExists},
+			sdkRetried:  true,
+			expectAdopt: true,
+		},
+		"already exists on the first attempt": {
+			errs:        []error{errAlreadyExists},
+			expectError: neptune.ErrCodeDBClusterEndpointAlreadyExistsFault,
+		},
+		"retry succeeds": {
+			errs: []error{errInvalidState, nil},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			var calls, adopts int
+
+			create := adoptWhenAlreadyExistsOnRetry(func() (interface{}, bool, error) {
+				err := testCase.errs[calls]
+				calls++
+
+				if err != nil {
+					return nil, testCase.sdkRetried, err
+				}
+
+				return &neptune.CreateDBClusterEndpointOutput{}, false, nil
+			}, func() (interface{}, error) {
+				adopts++
+
+				return adopted, nil
+			})
+
+			output, err := retryWhenClusterStateInvalidWithSleep(10*time.Minute, create, func(time.Duration) {})
+
+			if calls != len(testCase.errs) {
+				t.Errorf("got %d calls, want %d", calls, len(testCase.errs))
+			}
+
+			if testCase.expectError != "" {
+				if !tfawserr.ErrCodeEquals(err, testCase.expectError) {
+					t.Fatalf("got error %v, want %s", err, testCase.expectError)
+				}
+
+				if adopts != 0 {
+					t.Errorf("got %d adopts, want none", adopts)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.expectAdopt != (adopts == 1) || adopts > 1 {
+				t.Errorf("got %d adopts, expected adopt: %t", adopts, testCase.expectAdopt)
+			}
+
+			if testCase.expectAdopt && output != adopted {
+				t.Errorf("got output %v, want the adopted endpoint", output)
+			}
+		})
+	}
+}
+
+func TestDBClusterEndpointCreateOutcomeUnknown(t *testing.T) {
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"success": {},
+		"API error": {
+			err: awserr.NewRequestFailure(awserr.New(neptune.ErrCodeInvalidDBClusterStateFault, "DB cluster is not in the available state", nil), 400, ""),
+		},
+		"server error": {
+			err: awserr.NewRequestFailure(awserr.New("InternalFailure", "internal failure", nil), 500, ""),
+		},
+		"network error": {
+			err:      awserr.New(request.ErrCodeRequestError, "send request failed", errors.New("connection reset by peer")),
+			expected: true,
+		},
+		"unreadable response": {
+			err:      awserr.NewRequestFailure(awserr.New(request.ErrCodeSerialization, "failed decoding response", nil), 200, ""),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			if got := dbClusterEndpointCreateOutcomeUnknown(testCase.err); got != testCase.expected {
+				t.Errorf("got %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+// mockCreateDBClusterEndpointConn returns a client whose CreateDBClusterEndpoint
+// attempts, including SDK retries, fail with the given errors in turn.
+func mockCreateDBClusterEndpointConn(t *testing.T, errs []error) (*neptune.Neptune, *int) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		Region:      aws.String("us-east-1"),
+		SleepDelay:  func(time.Duration) {},
+	})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := neptune.New(sess)
+	calls := 0
+
+	conn.Handlers.Send.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		if calls >= len(errs) {
+			t.Fatalf("unexpected attempt %d", calls+1)
+		}
+
+		// The retryer reads the response of a throttled attempt.
+		r.HTTPResponse = &http.Response{StatusCode: http.StatusBadRequest, Header: http.Header{}}
+		r.Error = errs[calls]
+		calls++
+	})
+
+	return conn, &calls
+}
+
+func TestSendDBClusterEndpointCreate(t *testing.T) {
+	errThrottling := awserr.NewRequestFailure(awserr.New("Throttling", "Rate exceeded", nil), 400, "")
+	errNetwork := awserr.New(request.ErrCodeRequestError, "send request failed", errors.New("connection reset by peer"))
+	errAlreadyExists := awserr.NewRequestFailure(awserr.New(neptune.ErrCodeDBClusterEndpointAlreadyExistsFault, "endpoint already exists", nil), 400, "")
+
+	testCases := map[string]struct {
+		errs        []error
+		expectAdopt bool
+	}{
+		"throttled then AlreadyExists must not adopt": {
+			errs: []error{errThrottling, errAlreadyExists},
+		},
+		"network error then AlreadyExists adopts": {
+			errs:        []error{errNetwork, errAlreadyExists},
+			expectAdopt: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			conn, calls := mockCreateDBClusterEndpointConn(t, testCase.errs)
+			adopts := 0
+
+			create := adoptWhenAlreadyExistsOnRetry(func() (interface{}, bool, error) {
+				return sendDBClusterEndpointCreate(conn, &neptune.CreateDBClusterEndpointInput{
+					DBClusterEndpointIdentifier: aws.String("test"),
+					DBClusterIdentifier:         aws.String("test"),
+					EndpointType:                aws.String(clusterEndpointTypeReader),
+				})
+			}, func() (interface{}, error) {
+				adopts++
+
+				return &neptune.CreateDBClusterEndpointOutput{}, nil
+			})
+
+			_, err := create()
+
+			if *calls != len(testCase.errs) {
+				t.Errorf("got %d attempts, want %d", *calls, len(testCase.errs))
+			}
+
+			if testCase.expectAdopt {
+				if err != nil || adopts != 1 {
+					t.Errorf("got error %v and %d adopts, want the endpoint adopted", err, adopts)
+				}
+				return
+			}
+
+			if !tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterEndpointAlreadyExistsFault) || adopts != 0 {
+				t.Errorf("got error %v and %d adopts, want %s", err, adopts, neptune.ErrCodeDBClusterEndpointAlreadyExistsFault)
+			}
+		})
+	}
+}